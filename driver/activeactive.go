@@ -0,0 +1,134 @@
+package driver
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"strconv"
+	"sync"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+// activeActiveProtocolVersion is the Active/Active protocol version supported by the driver.
+const activeActiveProtocolVersion = 1
+
+/*
+readOnlyRouter implements the routing of read-only transactions to the secondary site of an
+Active/Active (read enabled) system replication.
+
+The primary session is the session of the connection. The secondary session is opened on demand to the
+secondary site host reported in the topology information of the primary session and is associated to the
+primary session via its connection id.
+*/
+type readOnlyRouter struct {
+	logger  *slog.Logger
+	metrics *metrics
+	attrs   *connAttrs
+	primary *session
+
+	mu        sync.Mutex
+	secondary *session
+}
+
+func newReadOnlyRouter(logger *slog.Logger, metrics *metrics, attrs *connAttrs, primary *session) *readOnlyRouter {
+	return &readOnlyRouter{logger: logger, metrics: metrics, attrs: attrs, primary: primary}
+}
+
+/*
+session returns the session a read-only transaction should be executed on.
+
+The primary session is returned if
+  - the database server does not support the Active/Active protocol,
+  - no secondary site host is part of the topology or
+  - a connection to the secondary site host cannot be established.
+*/
+func (r *readOnlyRouter) session(ctx context.Context) *session {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.secondary != nil {
+		if !r.secondary.isBad() {
+			r.metrics.msgCh <- counterMsg{idx: counterSecondaryTx, v: 1}
+			return r.secondary
+		}
+		r.secondary.close()
+		r.secondary = nil
+	}
+
+	host, ok := r.primary.secondaryHost()
+	if !r.primary.activeActive || !ok {
+		r.metrics.msgCh <- counterMsg{idx: counterSecondaryFallbacks, v: 1}
+		return r.primary
+	}
+	addr := net.JoinHostPort(host.Host, strconv.Itoa(host.Port))
+
+	attrs := *r.attrs
+	attrs.associatedConnID = r.primary.connectionID
+
+	session, err := newSession(ctx, addr, r.logger.With(slog.String("site", "secondary")), r.metrics, &attrs, attrs.authHndFn())
+	if err != nil {
+		r.logger.LogAttrs(ctx, slog.LevelWarn, "read-only routing: cannot connect to secondary site - using primary connection", slog.String("host", addr), slog.Any("error", err))
+		r.metrics.msgCh <- counterMsg{idx: counterSecondaryFallbacks, v: 1}
+		return r.primary
+	}
+	r.secondary = session
+	r.metrics.msgCh <- counterMsg{idx: counterSecondaryTx, v: 1}
+	return session
+}
+
+// close closes the secondary session.
+func (r *readOnlyRouter) close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.secondary == nil {
+		return nil
+	}
+	err := r.secondary.close()
+	r.secondary = nil
+	return err
+}
+
+// secondaryHost returns the index server host of the secondary site reported in the topology information.
+func (s *session) secondaryHost() (*p.TopologyHost, bool) {
+	var secondary *p.TopologyHost
+	for _, host := range s.topology {
+		if host.SiteType != p.SiteTypeSecondary || host.ServiceType != p.StIndexServer {
+			continue
+		}
+		if host.IsPrimary { // prefer master index server
+			return host, true
+		}
+		if secondary == nil {
+			secondary = host
+		}
+	}
+	return secondary, secondary != nil
+}
+
+/*
+routeReadOnlyTx sets the secondary session as session of a read-only transaction.
+
+The session of the connection (primary session) is not changed. Statements of the transaction are executed
+on the session returned by txSession.
+*/
+func (c *conn) routeReadOnlyTx(ctx context.Context) {
+	if c.roRouter == nil {
+		return
+	}
+	if session := c.roRouter.session(ctx); session != c.session {
+		c.roSession.Store(session)
+	}
+}
+
+// endReadOnlyTx resets the session of a read-only transaction.
+func (c *conn) endReadOnlyTx() { c.roSession.Store(nil) }
+
+// txSession returns the session statements are executed on: the secondary session within a routed read-only transaction,
+// the session of the connection otherwise.
+func (c *conn) txSession() *session {
+	if session := c.roSession.Load(); session != nil {
+		return session
+	}
+	return c.session
+}
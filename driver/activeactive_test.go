@@ -0,0 +1,40 @@
+package driver
+
+import (
+	"testing"
+)
+
+func TestReadOnlyTxSession(t *testing.T) {
+	primary, secondary := &session{}, &session{}
+	primaryPR, secondaryPR := &prepareResult{}, &prepareResult{}
+	c := &conn{session: primary}
+
+	checkSession := func(s *stmt, session *session, pr *prepareResult) {
+		t.Helper()
+		if err := s.useSession(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+		if s.session != session || s.pr != pr {
+			t.Fatal("statement executed on invalid session")
+		}
+	}
+
+	// prepare results of other sessions are cached (prepared once)
+	s := &stmt{session: primary, prepSession: primary, prepPR: primaryPR, connSession: c.txSession, sessionPRs: map[*session]*prepareResult{secondary: secondaryPR}}
+	checkSession(s, primary, primaryPR)
+
+	c.roSession.Store(secondary) // routed read-only transaction
+	if c.session != primary || c.txSession() != secondary {
+		t.Fatal("invalid sessions of routed read-only transaction")
+	}
+	checkSession(s, secondary, secondaryPR)
+	txStmt := &stmt{session: secondary, prepSession: secondary, prepPR: secondaryPR, connSession: c.txSession, sessionPRs: map[*session]*prepareResult{primary: primaryPR}}
+	checkSession(txStmt, secondary, secondaryPR)
+
+	c.endReadOnlyTx()
+	if c.txSession() != primary {
+		t.Fatal("primary session expected after read-only transaction")
+	}
+	checkSession(s, primary, primaryPR)
+	checkSession(txStmt, primary, primaryPR)
+}
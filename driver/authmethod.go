@@ -0,0 +1,157 @@
+package driver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/auth"
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
+)
+
+// Authentication method orders of the authentication methods supported by the driver.
+// The order defines the position of an authentication method in the authentication init request.
+const (
+	AuthOrderSessionCookie     = auth.MoSessionCookie
+	AuthOrderX509              = auth.MoX509
+	AuthOrderJWT               = auth.MoJWT
+	AuthOrderSAML              = auth.MoSAML
+	AuthOrderSCRAMPBKDF2SHA256 = auth.MoSCRAMPBKDF2SHA256
+	AuthOrderSCRAMSHA256       = auth.MoSCRAMSHA256
+	AuthOrderLDAP              = auth.MoLDAP
+)
+
+// Authentication method types of the authentication methods supported by the driver (see Connector.SetAuthMethods).
+const (
+	AuthTypeSessionCookie     = auth.MtSessionCookie
+	AuthTypeX509              = auth.MtX509
+	AuthTypeJWT               = auth.MtJWT
+	AuthTypeSAML              = auth.MtSAML
+	AuthTypeSCRAMPBKDF2SHA256 = auth.MtSCRAMPBKDF2SHA256
+	AuthTypeSCRAMSHA256       = auth.MtSCRAMSHA256
+	AuthTypeLDAP              = auth.MtLDAP
+)
+
+// authMethodAllowed returns true if the authentication method type is part of allowed (if not empty) and not part of excluded.
+func authMethodAllowed(mt string, allowed, excluded []string) bool {
+	contains := func(mts []string) bool {
+		return slices.ContainsFunc(mts, func(s string) bool { return strings.EqualFold(s, mt) })
+	}
+	return (len(allowed) == 0 || contains(allowed)) && !contains(excluded)
+}
+
+/*
+AuthMethod is the interface of an authentication method.
+
+An authentication method takes part in the two step authentication exchange with the database server:
+  - PrepareInitReq adds the method type and the method specific parameters to the init request,
+  - InitRepDecode decodes the method specific init reply parameters in case the database server did select the method,
+  - PrepareFinalReq adds the final request parameters and
+  - FinalRepDecode decodes the final reply parameters.
+
+When InitRepDecode is called, the number of parameters and the method type of the init reply are already decoded
+by the driver, so that InitRepDecode decodes the method specific parameters only. FinalRepDecode decodes the complete
+final reply including the number of parameters and the method type.
+
+Typ returns the authentication method type sent to and returned by the database server and Order the position of the method
+in the init request (see AuthOrderSessionCookie, ...). Authentication methods can be registered via Connector.RegisterAuthMethod.
+Methods implementing the AuthCookieGetter interface support the reconnect via session cookie.
+*/
+type AuthMethod interface {
+	Typ() string
+	Order() byte
+	PrepareInitReq(prms *AuthPrms) error
+	InitRepDecode(d *AuthDecoder) error
+	PrepareFinalReq(prms *AuthPrms) error
+	FinalRepDecode(d *AuthDecoder) error
+}
+
+// AuthCookieGetter is implemented by authentication methods supporting a session cookie to reconnect.
+type AuthCookieGetter interface {
+	Cookie() (logonname string, cookie []byte)
+}
+
+// AuthPrms is the encoder of authentication request parameters.
+type AuthPrms struct {
+	prms *auth.Prms
+}
+
+// AddString adds a string parameter (ASCII, e.g. the method type).
+func (p *AuthPrms) AddString(s string) { p.prms.AddString(s) }
+
+// AddCESU8String adds an unicode string parameter (e.g. a logon name).
+func (p *AuthPrms) AddCESU8String(s string) { p.prms.AddCESU8String(s) }
+
+// AddBytes adds a bytes parameter.
+func (p *AuthPrms) AddBytes(b []byte) { p.prms.AddBytes(b) }
+
+// AddEmpty adds an empty parameter.
+func (p *AuthPrms) AddEmpty() { p.prms.AddEmpty() }
+
+// AddPrms adds a nested parameter list and returns it.
+func (p *AuthPrms) AddPrms() *AuthPrms { return &AuthPrms{prms: p.prms.AddPrms()} }
+
+// AuthDecoder is the decoder of authentication reply parameters.
+type AuthDecoder struct {
+	d *encoding.Decoder
+}
+
+// NumPrm decodes the number of parameters.
+func (d *AuthDecoder) NumPrm() int { return int(d.d.Int16()) }
+
+// CheckNumPrm decodes the number of parameters and returns an error if not equal expected.
+func (d *AuthDecoder) CheckNumPrm(expected int) error {
+	return auth.DecodeAndCheckNumPrm(d.d, expected)
+}
+
+// PrmsSize decodes the size in bytes of a nested parameter list.
+func (d *AuthDecoder) PrmsSize() int { return d.d.AuthVarFieldInd() }
+
+// Bytes decodes a bytes parameter.
+func (d *AuthDecoder) Bytes() []byte { return d.d.AuthBytes() }
+
+// String decodes a string parameter (ASCII, e.g. the method type).
+func (d *AuthDecoder) String() string { return d.d.AuthString() }
+
+// CESU8String decodes an unicode string parameter (e.g. a logon name).
+func (d *AuthDecoder) CESU8String() (string, error) { return d.d.AuthCesu8String() }
+
+// authMethod adapts an AuthMethod to the protocol authentication method interface.
+type authMethod struct {
+	m AuthMethod
+}
+
+// cookieAuthMethod adapts an AuthMethod supporting session cookies.
+type cookieAuthMethod struct {
+	authMethod
+}
+
+var (
+	_ auth.Method       = (*authMethod)(nil)
+	_ auth.CookieGetter = (*cookieAuthMethod)(nil)
+)
+
+func newAuthMethod(m AuthMethod) auth.Method {
+	if _, ok := m.(AuthCookieGetter); ok {
+		return &cookieAuthMethod{authMethod{m: m}}
+	}
+	return &authMethod{m: m}
+}
+
+func (a *authMethod) String() string { return fmt.Sprintf("method type %s", a.m.Typ()) }
+func (a *authMethod) Typ() string    { return a.m.Typ() }
+func (a *authMethod) Order() byte    { return a.m.Order() }
+func (a *authMethod) PrepareInitReq(prms *auth.Prms) error {
+	return a.m.PrepareInitReq(&AuthPrms{prms: prms})
+}
+func (a *authMethod) InitRepDecode(d *encoding.Decoder) error {
+	return a.m.InitRepDecode(&AuthDecoder{d: d})
+}
+func (a *authMethod) PrepareFinalReq(prms *auth.Prms) error {
+	return a.m.PrepareFinalReq(&AuthPrms{prms: prms})
+}
+func (a *authMethod) FinalRepDecode(d *encoding.Decoder) error {
+	return a.m.FinalRepDecode(&AuthDecoder{d: d})
+}
+
+func (a *cookieAuthMethod) Cookie() (string, []byte) { return a.m.(AuthCookieGetter).Cookie() }
@@ -0,0 +1,132 @@
+package driver
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/auth"
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
+	"github.com/SAP/go-hdb/driver/unicode/cesu8"
+)
+
+type testAuthMethod struct {
+	token     string
+	logonname string
+	cookie    []byte
+}
+
+func (m *testAuthMethod) Typ() string { return "TEST" }
+func (m *testAuthMethod) Order() byte { return AuthOrderJWT }
+func (m *testAuthMethod) PrepareInitReq(prms *AuthPrms) error {
+	prms.AddString(m.Typ())
+	prms.AddString(m.token)
+	return nil
+}
+func (m *testAuthMethod) InitRepDecode(d *AuthDecoder) error {
+	// number of parameters and method type are decoded by the driver.
+	var err error
+	m.logonname, err = d.CESU8String()
+	return err
+}
+func (m *testAuthMethod) PrepareFinalReq(prms *AuthPrms) error {
+	prms.AddCESU8String(m.logonname)
+	prms.AddString(m.Typ())
+	prms.AddEmpty()
+	return nil
+}
+func (m *testAuthMethod) FinalRepDecode(d *AuthDecoder) error {
+	if err := d.CheckNumPrm(2); err != nil {
+		return err
+	}
+	if mt := d.String(); mt != m.Typ() {
+		return fmt.Errorf("invalid method type %s - expected %s", mt, m.Typ())
+	}
+	m.cookie = d.Bytes()
+	return nil
+}
+func (m *testAuthMethod) Cookie() (string, []byte) { return m.logonname, m.cookie }
+
+func TestAuthMethod(t *testing.T) {
+	m := &testAuthMethod{token: "dummy token"}
+	a := newAuthMethod(m)
+
+	if _, ok := a.(auth.CookieGetter); !ok {
+		t.Fatal("authentication method does not implement cookie getter")
+	}
+
+	encode := func(fn func(prms *auth.Prms) error) []byte {
+		prms := &auth.Prms{}
+		if err := fn(prms); err != nil {
+			t.Fatal(err)
+		}
+		buf := bytes.Buffer{}
+		if err := prms.Encode(encoding.NewEncoder(&buf, cesu8.DefaultEncoder())); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+	decoder := func(data []byte) *encoding.Decoder {
+		return encoding.NewDecoder(bytes.NewBuffer(data), cesu8.DefaultDecoder(), false)
+	}
+
+	if actual, expected := encode(a.PrepareInitReq), []byte("\x02\x00\x04TEST\x0Bdummy token"); !bytes.Equal(actual, expected) {
+		t.Fatalf("init request %q - expected %q", actual, expected)
+	}
+	if err := a.InitRepDecode(decoder([]byte("\x07USER123"))); err != nil {
+		t.Fatal(err)
+	}
+	if m.logonname != "USER123" {
+		t.Fatalf("logonname %s - expected %s", m.logonname, "USER123")
+	}
+	if actual, expected := encode(a.PrepareFinalReq), []byte("\x03\x00\x07USER123\x04TEST\x00"); !bytes.Equal(actual, expected) {
+		t.Fatalf("final request %q - expected %q", actual, expected)
+	}
+	if err := a.FinalRepDecode(decoder([]byte("\x02\x00\x04TEST\x06cookie"))); err != nil {
+		t.Fatal(err)
+	}
+	if _, cookie := m.Cookie(); string(cookie) != "cookie" {
+		t.Fatalf("cookie %s - expected %s", cookie, "cookie")
+	}
+	if err := a.FinalRepDecode(decoder([]byte("\x01\x00\x00"))); err == nil {
+		t.Fatal("expected number of parameters error")
+	}
+}
+
+func TestAuthMethodAllowed(t *testing.T) {
+	testData := []struct {
+		mt                string
+		allowed, excluded []string
+		result            bool
+	}{
+		{AuthTypeSCRAMSHA256, nil, nil, true},
+		{AuthTypeSCRAMSHA256, []string{AuthTypeSCRAMPBKDF2SHA256}, nil, false},
+		{AuthTypeSCRAMPBKDF2SHA256, []string{"scrampbkdf2sha256"}, nil, true},
+		{AuthTypeSCRAMSHA256, nil, []string{AuthTypeSCRAMSHA256}, false},
+		{AuthTypeLDAP, nil, []string{AuthTypeSCRAMSHA256}, true},
+	}
+
+	for i, d := range testData {
+		if result := authMethodAllowed(d.mt, d.allowed, d.excluded); result != d.result {
+			t.Fatalf("%d: result %t - expected %t", i, result, d.result)
+		}
+	}
+
+	// no authentication method left
+	c := NewBasicAuthConnector("host:30015", "user", "password")
+	c.SetAuthMethods(AuthTypeJWT)
+	if _, err := c.authHnd().InitRequest(); err == nil {
+		t.Fatal("expected no authentication method error")
+	}
+}
+
+func TestAuthMethodOrder(t *testing.T) {
+	// custom method with the same order as a built-in method.
+	methods := auth.Methods{auth.MtJWT: auth.NewJWT("token"), "TEST": newAuthMethod(&testAuthMethod{})}
+	for range 20 { // map iteration order is random
+		ordered := methods.Order()
+		if ordered[0].Typ() != auth.MtJWT || ordered[1].Typ() != "TEST" {
+			t.Fatalf("method order %s %s - expected %s %s", ordered[0].Typ(), ordered[1].Typ(), auth.MtJWT, "TEST")
+		}
+	}
+}
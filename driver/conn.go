@@ -49,6 +49,8 @@ var (
 	_ = p.RegisterScanType(p.DtBytes, reflect.TypeFor[[]byte](), reflect.TypeFor[NullBytes]())
 	_ = p.RegisterScanType(p.DtDecimal, reflect.TypeFor[Decimal](), reflect.TypeFor[NullDecimal]())
 	_ = p.RegisterScanType(p.DtLob, reflect.TypeFor[Lob](), reflect.TypeFor[NullLob]())
+	_ = p.RegisterScanType(p.DtRealVector, reflect.TypeFor[[]float32](), reflect.TypeFor[NullRealVector]())
+	_ = p.RegisterScanType(p.DtInterval, reflect.TypeFor[Interval](), reflect.TypeFor[NullInterval]())
 )
 
 // check if conn implements all required interfaces.
@@ -126,11 +128,15 @@ func (t *connTracker) callDB() *sql.DB {
 
 // Conn is the implementation of the database/sql/driver Conn interface.
 type conn struct {
-	attrs   *connAttrs
-	metrics *metrics
-	logger  *slog.Logger
-	session *session
-	wg      *sync.WaitGroup // wait for concurrent db calls when closing connections.
+	attrs    *connAttrs
+	metrics  *metrics
+	logger   *slog.Logger
+	session  *session
+	router   *router         // client-side statement routing (nil if disabled).
+	roRouter *readOnlyRouter // read-only transaction routing (nil if disabled).
+	wg       *sync.WaitGroup // wait for concurrent db calls when closing connections.
+
+	roSession atomic.Pointer[session] // secondary session while a read-only transaction is routed to the secondary site.
 }
 
 // isAuthError returns true in case of X509 certificate validation errors or hdb authentication errors, else otherwise.
@@ -165,12 +171,29 @@ func newConn(ctx context.Context, host string, metrics *metrics, attrs *connAttr
 	return &conn{attrs: attrs, metrics: metrics, logger: logger, session: session, wg: new(sync.WaitGroup)}, nil
 }
 
+// enableRouting enables client-side statement routing and read-only transaction routing for the connection if configured.
+func (c *conn) enableRouting() {
+	if c.attrs.statementRouting {
+		c.router = newRouter(c.logger, c.metrics, c.attrs, c.session)
+	}
+	if c.attrs.readOnlyRouting {
+		c.roRouter = newReadOnlyRouter(c.logger, c.metrics, c.attrs, c.session)
+	}
+}
+
 // Close implements the driver.Conn interface.
 func (c *conn) Close() error {
 	c.metrics.msgCh <- gaugeMsg{idx: gaugeConn, v: -1} // decrement open connections.
 	stdConnTracker.remove()
+	c.endReadOnlyTx()
 	err := c.session.close()
 	c.wg.Wait()
+	if c.router != nil {
+		err = errors.Join(err, c.router.close())
+	}
+	if c.roRouter != nil {
+		err = errors.Join(err, c.roRouter.close())
+	}
 	return err
 }
 
@@ -206,7 +229,7 @@ func (c *conn) Ping(ctx context.Context) error {
 
 	select {
 	case <-ctx.Done():
-		c.session.cancel()
+		c.session.cancelStmt(done)
 		return ctx.Err()
 	case <-done:
 		return sqlErr
@@ -215,19 +238,25 @@ func (c *conn) Ping(ctx context.Context) error {
 
 // PrepareContext implements the driver.ConnPrepareContext interface.
 func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	session := c.txSession()
+	router := c.router
+	if session != c.session { // no statement routing within read-only transactions routed to the secondary site
+		router = nil
+	}
+
 	var sqlErr error
 	var stmt driver.Stmt
 	done := make(chan struct{})
 	c.wg.Go(func() {
 		defer close(done)
-		if sqlErr = c.session.switchUser(ctx); sqlErr != nil {
+		if sqlErr = session.switchUser(ctx); sqlErr != nil {
 			return
 		}
 		var pr *prepareResult
-		if pr, sqlErr = c.session.prepare(ctx, query); sqlErr != nil {
+		if pr, sqlErr = session.prepare(ctx, query); sqlErr != nil {
 			return
 		}
-		stmt = newStmt(c.session, c.wg, c.attrs, c.metrics, query, pr)
+		stmt = newStmt(session, c.txSession, router, c.wg, c.attrs, c.metrics, query, pr)
 		if stmtMetadata, ok := ctx.Value(stmtMetadataCtxKey).(*StmtMetadata); ok {
 			*stmtMetadata = pr
 		}
@@ -235,7 +264,9 @@ func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, e
 
 	select {
 	case <-ctx.Done():
-		c.session.cancel()
+		if session.cancelStmt(done) && stmt != nil {
+			stmt.Close() // release the statement prepared before the cancellation took effect.
+		}
 		return nil, ctx.Err()
 	case <-done:
 		return stmt, sqlErr
@@ -244,7 +275,7 @@ func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, e
 
 // BeginTx implements the driver.ConnBeginTx interface.
 func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
-	if c.session.inTx.Load() {
+	if c.roSession.Load() != nil || c.session.inTx.Load() {
 		return nil, ErrNestedTransaction
 	}
 
@@ -267,31 +298,42 @@ func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, e
 		accessModeQuery = setAccessModeReadWrite
 	}
 
+	if opts.ReadOnly {
+		c.routeReadOnlyTx(ctx)
+	}
+	session := c.txSession()
+
 	var sqlErr error
 	var tx driver.Tx
 	done := make(chan struct{})
 	c.wg.Go(func() {
 		defer close(done)
-		if sqlErr = c.session.switchUser(ctx); sqlErr != nil {
+		if sqlErr = session.switchUser(ctx); sqlErr != nil {
 			return
 		}
 		// set isolation level
-		if _, sqlErr = c.session.execDirect(ctx, isolationLevelQuery); sqlErr != nil {
+		if _, sqlErr = session.execDirect(ctx, isolationLevelQuery); sqlErr != nil {
 			return
 		}
 		// set access mode
-		if _, sqlErr = c.session.execDirect(ctx, accessModeQuery); sqlErr != nil {
+		if _, sqlErr = session.execDirect(ctx, accessModeQuery); sqlErr != nil {
 			return
 		}
-		tx = newTx(c)
-		c.session.inTx.Store(true)
+		tx = newTx(c, session)
+		session.inTx.Store(true)
 	})
 
 	select {
 	case <-ctx.Done():
-		c.session.cancel()
+		if session.cancelStmt(done) && tx != nil {
+			tx.Rollback() //nolint:errcheck // end the transaction started before the cancellation took effect.
+		}
+		c.endReadOnlyTx()
 		return nil, ctx.Err()
 	case <-done:
+		if sqlErr != nil {
+			c.endReadOnlyTx()
+		}
 		return tx, sqlErr
 	}
 }
@@ -303,21 +345,25 @@ func (c *conn) QueryContext(ctx context.Context, query string, nvargs []driver.N
 	if len(nvargs) != 0 {
 		return nil, driver.ErrSkip // fast path not possible (prepare needed)
 	}
+	resetServerStmtStats(ctx)
+	session := c.txSession()
 
 	var sqlErr error
 	var rows driver.Rows
 	done := make(chan struct{})
 	c.wg.Go(func() {
 		defer close(done)
-		if sqlErr = c.session.switchUser(ctx); sqlErr != nil {
+		if sqlErr = session.switchUser(ctx); sqlErr != nil {
 			return
 		}
-		rows, sqlErr = c.session.queryDirect(ctx, query, traceQuery)
+		rows, sqlErr = session.queryDirect(ctx, query, traceQuery)
 	})
 
 	select {
 	case <-ctx.Done():
-		c.session.cancel()
+		if session.cancelStmt(done) && rows != nil {
+			rows.Close() // release the resultset opened before the cancellation took effect.
+		}
 		return nil, ctx.Err()
 	case <-done:
 		return rows, sqlErr
@@ -326,25 +372,30 @@ func (c *conn) QueryContext(ctx context.Context, query string, nvargs []driver.N
 
 // ExecContext implements the driver.ExecerContext interface.
 func (c *conn) ExecContext(ctx context.Context, query string, nvargs []driver.NamedValue) (driver.Result, error) {
+	if spo, ok := ctx.Value(savepointCtxKey).(*savepointOp); ok {
+		return driver.ResultNoRows, c.execSavepointOp(ctx, spo)
+	}
 	if len(nvargs) != 0 {
 		return nil, driver.ErrSkip // fast path not possible (prepare needed)
 	}
+	resetServerStmtStats(ctx)
+	session := c.txSession()
 
 	var sqlErr error
 	var result driver.Result
 	done := make(chan struct{})
 	c.wg.Go(func() {
 		defer close(done)
-		if sqlErr = c.session.switchUser(ctx); sqlErr != nil {
+		if sqlErr = session.switchUser(ctx); sqlErr != nil {
 			return
 		}
 		// handle procedure call without parameters here as well
-		result, sqlErr = c.session.execDirect(ctx, query)
+		result, sqlErr = session.execDirect(ctx, query)
 	})
 
 	select {
 	case <-ctx.Done():
-		c.session.cancel()
+		session.cancelStmt(done)
 		return nil, ctx.Err()
 	case <-done:
 		return result, sqlErr
@@ -381,7 +432,7 @@ func (c *conn) DBConnectInfo(ctx context.Context, databaseName string) (*DBConne
 
 	select {
 	case <-ctx.Done():
-		c.session.cancel()
+		c.session.cancelStmt(done)
 		return nil, ctx.Err()
 	case <-done:
 		return ci, sqlErr
@@ -396,28 +447,31 @@ var (
 )
 
 type tx struct {
-	conn   *conn
-	closed atomic.Bool
+	conn    *conn
+	session *session // session the transaction is executed on
+	closed  atomic.Bool
 }
 
-func newTx(conn *conn) *tx {
+func newTx(conn *conn, session *session) *tx {
 	conn.metrics.msgCh <- gaugeMsg{idx: gaugeTx, v: 1} // increment number of transactions.
-	return &tx{conn: conn}
+	return &tx{conn: conn, session: session}
 }
 
 func (t *tx) Commit() error   { return t.close(false) }
 func (t *tx) Rollback() error { return t.close(true) }
 
 func (t *tx) close(rollback bool) error {
-	c := t.conn
+	c, session := t.conn, t.session
 
 	c.metrics.msgCh <- gaugeMsg{idx: gaugeTx, v: -1} // decrement number of transactions.
 
 	defer func() {
-		c.session.inTx.Store(false)
+		session.inTx.Store(false)
+		session.savepoints = nil
+		c.endReadOnlyTx()
 	}()
 
-	if c.session.isBad() {
+	if session.isBad() {
 		return driver.ErrBadConn
 	}
 	if closed := t.closed.Swap(true); closed {
@@ -425,7 +479,7 @@ func (t *tx) close(rollback bool) error {
 	}
 
 	if rollback {
-		return c.session.rollback(context.Background())
+		return session.rollback(context.Background())
 	}
-	return c.session.commit(context.Background())
+	return session.commit(context.Background())
 }
@@ -40,6 +40,45 @@ func testCancelContext(t *testing.T, db *sql.DB) {
 	}
 }
 
+func testCancelSession(t *testing.T, db *sql.DB) {
+	connector := MT.NewConnector()
+	connector.SetCancelSession(true)
+	db = sql.OpenDB(connector)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	connectionID := func() int {
+		var id int
+		if err := db.QueryRow("select current_connection from dummy").Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+
+	stmt, err := db.Prepare("select * from dummy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmt.Close()
+
+	id := connectionID()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	hookCtx := withConnHook(ctx, func(op int) {
+		if op == choStmtExec {
+			cancel()
+		}
+	})
+	if _, err := stmt.ExecContext(hookCtx); !errors.Is(err, context.Canceled) {
+		t.Fatal(err)
+	}
+
+	// connection should not have been invalidated.
+	if newID := connectionID(); newID != id {
+		t.Fatalf("connection id %d - expected %d", newID, id)
+	}
+}
+
 func TestConnection(t *testing.T) {
 	t.Parallel()
 
@@ -48,6 +87,7 @@ func TestConnection(t *testing.T) {
 		fct  func(t *testing.T, db *sql.DB)
 	}{
 		{"cancelContext", testCancelContext},
+		{"cancelSession", testCancelSession},
 	}
 
 	db := MT.DB()
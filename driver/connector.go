@@ -2,6 +2,7 @@ package driver
 
 import (
 	"context"
+	"crypto"
 	"crypto/tls"
 	"crypto/x509"
 	"database/sql/driver"
@@ -12,6 +13,7 @@ import (
 	"net"
 	"os"
 	"path"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -83,10 +85,19 @@ type connAttrs struct {
 	locale             string
 	fetchSize          int
 	lobChunkSize       int
+	lobPrefetch        int
 	dfv                int
 	cesu8Decoder       transform.Transformer
 	cesu8Encoder       transform.Transformer
 	emptyDateAsNull    bool
+	compression        bool
+	xa                 bool
+	statementRouting   bool
+	anchorConnectionID int // connection id of the anchor connection (routed sessions only)
+	cancelSession      bool
+	readOnlyRouting    bool
+	associatedConnID   int               // connection id of the primary connection (secondary sessions only)
+	authHndFn          func() *p.AuthHnd // authentication of additional sessions (statement routing, read-only routing, cancellation)
 	logger             *slog.Logger
 }
 
@@ -100,6 +111,9 @@ func readCertKeyFiles(certFile, keyFile string) (unique.Handle[string], unique.H
 	if err != nil {
 		return handle, handle, err
 	}
+	if keyFile == "" { // PKCS#12 bundle: empty key
+		return unique.Make(string(cert)), unique.Make(""), nil
+	}
 	key, err := os.ReadFile(keyFile)
 	if err != nil {
 		return handle, handle, err
@@ -109,6 +123,8 @@ func readCertKeyFiles(certFile, keyFile string) (unique.Handle[string], unique.H
 
 func isJWTToken(token string) bool { return strings.HasPrefix(token, "ey") }
 
+func pbkdf2Rounds(rounds int) uint32 { return uint32(min(rounds, math.MaxUint32)) } //nolint:gosec // value range checked
+
 /*
 A Connector represents a hdb driver in a fixed configuration.
 A Connector can be passed to sql.OpenDB allowing users to bypass a string based data source name.
@@ -133,23 +149,45 @@ type Connector struct {
 	_locale             string
 	_fetchSize          int
 	_lobChunkSize       int
+	_lobPrefetch        int
 	_dfv                int
 	_cesu8DecoderFn     func() transform.Transformer
 	_cesu8EncoderFn     func() transform.Transformer
 	_emptyDateAsNull    bool
+	_compression        bool
+	_xa                 bool
+	_statementRouting   bool
+	_cancelSession      bool
+	_readOnlyRouting    bool
+	_loadBalancing      LoadBalancing
+	_blacklistPeriod    time.Duration
 	_logger             *slog.Logger
 
+	rrCounter            atomic.Uint64 // round-robin counter for multi-host connectors
+	hostBlacklist        hostBlacklist // unreachable hosts of multi-host connectors
 	hasCookie            atomic.Bool
 	_username, _password string // basic authentication
 	_certFile, _keyFile  string
+	_keyPassword         string        // password of encrypted client keys and PKCS#12 bundles
 	_certKey             *auth.CertKey // X509
 	_token               string        // JWT
-	_logonname           string        // session cookie login does need logon name provided by JWT authentication.
-	_sessionCookie       []byte        // authentication via session cookie (HDB currently does support only SAML and JWT - go-hdb JWT)
+	_assertion           string        // SAML
+	_logonname           string        // session cookie login does need logon name provided by JWT or SAML authentication.
+	_sessionCookie       []byte        // authentication via session cookie (HDB does support SAML and JWT)
+	_cookieClientID      string        // client id of the session cookie
+	_cookieStore         CookieStore
 	_refreshPasswordFn   func() (password string, ok bool)
 	_refreshClientCertFn func() (clientCert, clientKey []byte, ok bool)
 	_refreshTokenFn      func() (token string, ok bool)
-	cbmu                 sync.Mutex // prevents refresh callbacks from being called in parallel
+	_refreshAssertionFn  func() (assertion string, ok bool)
+	_credentialProvider  CredentialProvider
+	credentials          *Credentials // cached credentials of the credential provider
+	_authMethods         []string     // allowed authentication methods
+	_excludedAuthMethods []string
+	_pbkdf2MinRounds     int
+	_pbkdf2MaxRounds     int
+	_authMethodFns       []func() AuthMethod // registered authentication methods
+	cbmu                 sync.Mutex          // prevents refresh callbacks from being called in parallel
 
 	metrics *metrics
 }
@@ -169,6 +207,7 @@ func NewConnector() *Connector {
 		_dfv:                defaultDfv,
 		_cesu8DecoderFn:     cesu8.DefaultDecoder,
 		_cesu8EncoderFn:     cesu8.DefaultEncoder,
+		_blacklistPeriod:    defaultBlacklistPeriod,
 		_logger:             slog.Default(),
 		metrics:             stdHdbDriver.metrics, // use default stdHdbDriver metrics
 	}
@@ -185,11 +224,45 @@ func NewBasicAuthConnector(host, username, password string) *Connector {
 
 // NewX509AuthConnector creates a connector for X509 (client certificate) authentication.
 // Parameters clientCert and clientKey in PEM format, clientKey not password encrypted.
+// For password encrypted keys and PKCS#12 bundles please use NewX509AuthConnectorWithPassword.
 func NewX509AuthConnector(host string, clientCert, clientKey []byte) (*Connector, error) {
+	return NewX509AuthConnectorWithPassword(host, clientCert, clientKey, "")
+}
+
+/*
+NewX509AuthConnectorWithPassword creates a connector for X509 (client certificate) authentication
+supporting password protected client keys.
+
+Parameters clientCert and clientKey either
+  - in PEM format, where clientKey might be a password encrypted PKCS#8 key or
+  - clientCert as PKCS#12 (.p12, .pfx) bundle containing client certificate and client key, where clientKey is empty.
+
+The password is used to decrypt the client key or the PKCS#12 bundle.
+*/
+func NewX509AuthConnectorWithPassword(host string, clientCert, clientKey []byte, password string) (*Connector, error) {
+	c := NewConnector()
+	c._host = host
+	c._keyPassword = password
+	var err error
+	if c._certKey, err = auth.NewCertKey(unique.Make(string(clientCert)), unique.Make(string(clientKey)), password); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+/*
+NewX509AuthConnectorWithSigner creates a connector for X509 (client certificate) authentication
+where the client key is held by a crypto.Signer (e.g. keys stored in a HSM or a cloud KMS).
+
+The first certificate of certChain needs to be the client certificate belonging to the public key of the signer,
+followed by optional intermediate certificates. The signer is called to sign the server challenge and needs to support
+RSA (PKCS #1 v1.5 with SHA-256), ECDSA (ASN.1 encoded signature) or Ed25519 signatures.
+*/
+func NewX509AuthConnectorWithSigner(host string, certChain []*x509.Certificate, signer crypto.Signer) (*Connector, error) {
 	c := NewConnector()
 	c._host = host
 	var err error
-	if c._certKey, err = auth.NewCertKey(unique.Make(string(clientCert)), unique.Make(string(clientKey))); err != nil {
+	if c._certKey, err = auth.NewCertSigner(certChain, signer); err != nil {
 		return nil, err
 	}
 	return c, nil
@@ -198,25 +271,42 @@ func NewX509AuthConnector(host string, clientCert, clientKey []byte) (*Connector
 // NewX509AuthConnectorByFiles creates a connector for X509 (client certificate) authentication
 // based on client certificate and client key files.
 // Parameters clientCertFile and clientKeyFile in PEM format, clientKeyFile not password encrypted.
+// For password encrypted keys and PKCS#12 bundles please use NewX509AuthConnectorByFilesWithPassword.
 func NewX509AuthConnectorByFiles(host, clientCertFile, clientKeyFile string) (*Connector, error) {
+	return NewX509AuthConnectorByFilesWithPassword(host, clientCertFile, clientKeyFile, "")
+}
+
+// NewX509AuthConnectorByFilesWithPassword creates a connector for X509 (client certificate) authentication
+// based on client certificate and client key files supporting password protected client keys.
+// For the supported formats please see NewX509AuthConnectorWithPassword. In case of a PKCS#12 bundle
+// clientCertFile is the bundle file and clientKeyFile is empty.
+func NewX509AuthConnectorByFilesWithPassword(host, clientCertFile, clientKeyFile, password string) (*Connector, error) {
 	c := NewConnector()
 	c._host = host
+	if err := c.setCertKeyFiles(clientCertFile, clientKeyFile, password); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
 
-	clientCertFile = path.Clean(clientCertFile)
-	clientKeyFile = path.Clean(clientKeyFile)
+func (c *Connector) setCertKeyFiles(certFile, keyFile, password string) error {
+	certFile = path.Clean(certFile)
+	if keyFile != "" {
+		keyFile = path.Clean(keyFile)
+	}
 
-	certHandle, keyHandle, err := readCertKeyFiles(clientCertFile, clientKeyFile)
+	certHandle, keyHandle, err := readCertKeyFiles(certFile, keyFile)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if c._certKey, err = auth.NewCertKey(certHandle, keyHandle); err != nil {
-		return nil, err
+	if c._certKey, err = auth.NewCertKey(certHandle, keyHandle, password); err != nil {
+		return err
 	}
 
-	c._certFile = clientCertFile
-	c._keyFile = clientKeyFile
-
-	return c, nil
+	c._certFile = certFile
+	c._keyFile = keyFile
+	c._keyPassword = password
+	return nil
 }
 
 // NewJWTAuthConnector creates a connector for token (JWT) based authentication.
@@ -227,6 +317,14 @@ func NewJWTAuthConnector(host, token string) *Connector {
 	return c
 }
 
+// NewSAMLAuthConnector creates a connector for SAML bearer assertion based authentication.
+func NewSAMLAuthConnector(host, assertion string) *Connector {
+	c := NewConnector()
+	c._host = host
+	c._assertion = assertion
+	return c
+}
+
 func newDSNConnector(dsn *DSN) (*Connector, error) {
 	c := NewConnector()
 	c._host = dsn.host
@@ -239,8 +337,19 @@ func newDSNConnector(dsn *DSN) (*Connector, error) {
 			return nil, err
 		}
 	}
+	c._compression = dsn.compression
+	c._statementRouting = dsn.statementRouting
+	c._loadBalancing = dsn.loadBalancing
+	if dsn.blacklistPeriod != nil {
+		c._blacklistPeriod = *dsn.blacklistPeriod
+	}
 	c._username = dsn.username
 	c._password = dsn.password
+	if dsn.clientCertFile != "" {
+		if err := c.setCertKeyFiles(dsn.clientCertFile, dsn.clientKeyFile, dsn.clientKeyPassword); err != nil {
+			return nil, err
+		}
+	}
 	return c, nil
 }
 
@@ -257,13 +366,14 @@ func NewDSNConnector(dsnStr string) (*Connector, error) {
 func (c *Connector) NativeDriver() Driver { return stdHdbDriver }
 
 // Host returns the host of the connector.
+// In case of a multi-host connector the hosts are returned separated by comma.
 func (c *Connector) Host() string { return c._host }
 
 // DatabaseName returns the tenant database name of the connector.
 func (c *Connector) DatabaseName() string { return c._databaseName }
 
-func (c *Connector) fetchRedirectHost(ctx context.Context) (string, error) {
-	conn, err := newConn(ctx, c._host, c.metrics, c.connAttrs(), nil)
+func (c *Connector) fetchHostRedirectHost(ctx context.Context, host string) (string, error) {
+	conn, err := newConn(ctx, host, c.metrics, c.connAttrs(), nil)
 	if err != nil {
 		return "", err
 	}
@@ -273,7 +383,7 @@ func (c *Connector) fetchRedirectHost(ctx context.Context) (string, error) {
 		return "", err
 	}
 	if dbi.IsConnected { // if databaseName == "SYSTEMDB" and isConnected == true host and port are initial
-		return c._host, nil
+		return host, nil
 	}
 	return net.JoinHostPort(dbi.Host, strconv.Itoa(dbi.Port)), nil
 }
@@ -281,52 +391,79 @@ func (c *Connector) fetchRedirectHost(ctx context.Context) (string, error) {
 func (c *Connector) connect(ctx context.Context, host string) (driver.Conn, error) {
 	var connAttrs = c.connAttrs()
 
+	c.cbmu.Lock() // synchronize refresh calls
+	defer c.cbmu.Unlock()
+	// proactive refresh of expiring credentials
+	// - before the cookie authentication, as the token or assertion is part of the cookie store key
+	if _, err := c.updateCredentials(ctx, false); err != nil {
+		return nil, err
+	}
+
 	// can we connect via cookie?
-	if auth := c.cookieAuth(); auth != nil {
+	if auth := c.cookieAuth(ctx); auth != nil {
 		conn, err := newConn(ctx, host, c.metrics, connAttrs, auth)
 		if err == nil {
+			conn.enableRouting()
 			return conn, nil
 		}
 		if !isAuthError(err) {
 			return nil, err
 		}
-		c.invalidateCookie() // cookie auth was not successful - do not try again with the same data
+		c.invalidateCookie(ctx) // cookie auth was not successful - do not try again with the same data
 	}
 
-	c.cbmu.Lock() // synchronize refresh calls
-	defer c.cbmu.Unlock()
-	for {
-		authHnd := c.authHnd()
-
-		conn, connErr := newConn(ctx, host, c.metrics, connAttrs, authHnd)
-		if connErr == nil {
-			if method, ok := authHnd.Selected().(auth.CookieGetter); ok {
-				c.setCookie(method.Cookie())
-			}
-			return conn, nil
-		}
-		if !isAuthError(connErr) {
-			return nil, connErr
-		}
-
-		ok, err := c.refresh()
+	return c.authConnect(ctx, func(authHnd *p.AuthHnd) (*conn, error) {
+		conn, err := newConn(ctx, host, c.metrics, connAttrs, authHnd)
 		if err != nil {
 			return nil, err
 		}
-		if !ok { // no connection retry in case no refresh took place
-			return nil, connErr
+		if method, ok := authHnd.Selected().(auth.CookieGetter); ok {
+			logonname, cookie := method.Cookie()
+			c.setCookie(ctx, logonname, cookie)
 		}
+		conn.enableRouting()
+		return conn, nil
+	})
+}
+
+/*
+authConnect calls connect with the authentication methods of the connector.
+
+In case of an authentication error the credentials are refreshed and connect is called again if the credentials
+changed. The credentials are refreshed once only, as a token endpoint might return a new token on every request
+which the database server keeps rejecting.
+*/
+func (c *Connector) authConnect(ctx context.Context, connect func(authHnd *p.AuthHnd) (*conn, error)) (*conn, error) {
+	conn, connErr := connect(c.authHnd())
+	if connErr == nil || !isAuthError(connErr) {
+		return conn, connErr
+	}
+	ok, err := c.refresh(ctx)
+	if err != nil {
+		return nil, err
 	}
+	if !ok { // no connection retry in case no refresh took place
+		return nil, connErr
+	}
+	return connect(c.authHnd())
 }
 
+// redirect connects to the tenant database via the hosts of the connector (load balancing and blacklisting apply).
 func (c *Connector) redirect(ctx context.Context) (driver.Conn, error) {
-	if redirectHost, found := redirectCache.Load(redirectCacheKey{host: c._host, databaseName: c._databaseName}); found {
+	return connectHosts(ctx, c, func(host string) (driver.Conn, error) { return c.redirectHost(ctx, host) })
+}
+
+// redirectHost connects to the tenant database via host. The redirect host is cached per host and tenant database.
+func (c *Connector) redirectHost(ctx context.Context, host string) (driver.Conn, error) {
+	key := redirectCacheKey{host: host, databaseName: c._databaseName}
+	if redirectHost, found := redirectCache.Load(key); found {
 		if conn, err := c.connect(ctx, redirectHost.(string)); err == nil {
 			return conn, nil
 		}
+		redirectCache.Delete(key) // redirect host might have changed (e.g. tenant database moved)
 	}
 
-	redirectHost, err := c.fetchRedirectHost(ctx)
+	redirectHost, err := c.fetchHostRedirectHost(ctx, host)
 	if err != nil {
 		return nil, err
 	}
@@ -335,7 +472,7 @@ func (c *Connector) redirect(ctx context.Context) (driver.Conn, error) {
 		return nil, err
 	}
 
-	redirectCache.Store(redirectCacheKey{host: c._host, databaseName: c._databaseName}, redirectHost)
+	redirectCache.Store(key, redirectHost)
 
 	return conn, err
 }
@@ -345,7 +482,7 @@ func (c *Connector) Connect(ctx context.Context) (driver.Conn, error) {
 	if c._databaseName != "" {
 		return c.redirect(ctx)
 	}
-	return c.connect(ctx, c._host)
+	return connectHosts(ctx, c, func(host string) (driver.Conn, error) { return c.connect(ctx, host) })
 }
 
 // Driver implements the database/sql/driver/Connector interface.
@@ -373,21 +510,39 @@ func (c *Connector) clone() *Connector {
 		_locale:             c._locale,
 		_fetchSize:          c._fetchSize,
 		_lobChunkSize:       c._lobChunkSize,
+		_lobPrefetch:        c._lobPrefetch,
 		_dfv:                c._dfv,
 		_cesu8DecoderFn:     c._cesu8DecoderFn,
 		_cesu8EncoderFn:     c._cesu8EncoderFn,
 		_emptyDateAsNull:    c._emptyDateAsNull,
+		_compression:        c._compression,
+		_xa:                 c._xa,
+		_statementRouting:   c._statementRouting,
+		_cancelSession:      c._cancelSession,
+		_readOnlyRouting:    c._readOnlyRouting,
+		_loadBalancing:      c._loadBalancing,
+		_blacklistPeriod:    c._blacklistPeriod,
 		_logger:             c._logger,
 
 		_username:            c._username,
 		_password:            c._password,
 		_certFile:            c._certFile,
 		_keyFile:             c._keyFile,
+		_keyPassword:         c._keyPassword,
 		_certKey:             c._certKey,
 		_token:               c._token,
+		_assertion:           c._assertion,
 		_refreshPasswordFn:   c._refreshPasswordFn,
 		_refreshClientCertFn: c._refreshClientCertFn,
 		_refreshTokenFn:      c._refreshTokenFn,
+		_refreshAssertionFn:  c._refreshAssertionFn,
+		_credentialProvider:  c._credentialProvider,
+		_authMethodFns:       slices.Clone(c._authMethodFns),
+		_cookieStore:         c._cookieStore,
+		_authMethods:         slices.Clone(c._authMethods),
+		_excludedAuthMethods: slices.Clone(c._excludedAuthMethods),
+		_pbkdf2MinRounds:     c._pbkdf2MinRounds,
+		_pbkdf2MaxRounds:     c._pbkdf2MaxRounds,
 
 		metrics: c.metrics,
 	}
@@ -420,10 +575,17 @@ func (c *Connector) connAttrs() *connAttrs {
 		locale:             c._locale,
 		fetchSize:          c._fetchSize,
 		lobChunkSize:       c._lobChunkSize,
+		lobPrefetch:        c._lobPrefetch,
 		dfv:                c._dfv,
 		cesu8Decoder:       c._cesu8DecoderFn(),
 		cesu8Encoder:       c._cesu8EncoderFn(),
 		emptyDateAsNull:    c._emptyDateAsNull,
+		compression:        c._compression,
+		xa:                 c._xa,
+		statementRouting:   c._statementRouting,
+		cancelSession:      c._cancelSession,
+		readOnlyRouting:    c._readOnlyRouting,
+		authHndFn:          c.authHnd,
 		logger:             c._logger,
 	}
 }
@@ -505,6 +667,12 @@ func (c *Connector) setLobChunkSize(lobChunkSize int) {
 	}
 	c._lobChunkSize = lobChunkSize
 }
+func (c *Connector) setLobPrefetch(lobPrefetch int) {
+	if lobPrefetch < 0 {
+		lobPrefetch = 0
+	}
+	c._lobPrefetch = lobPrefetch
+}
 func (c *Connector) setDfv(dfv int) {
 	if !p.IsSupportedDfv(dfv) {
 		dfv = defaultDfv
@@ -698,6 +866,22 @@ func (c *Connector) SetLobChunkSize(lobChunkSize int) {
 	c.setLobChunkSize(lobChunkSize)
 }
 
+// LobPrefetch returns the number of lob read requests kept in flight while scanning binary lobs.
+func (c *Connector) LobPrefetch() int { c.mu.RLock(); defer c.mu.RUnlock(); return c._lobPrefetch }
+
+/*
+SetLobPrefetch sets the number of lob read requests kept in flight while scanning binary lobs.
+
+By default (values <= 1) lobs are read chunk by chunk with one round trip per chunk (see LobChunkSize). A value n > 1
+enables pipelined reads: up to n read requests are sent before the first reply is read, so that the network latency
+is paid once per n chunks instead of once per chunk. Character based lobs are always read sequentially.
+*/
+func (c *Connector) SetLobPrefetch(lobPrefetch int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setLobPrefetch(lobPrefetch)
+}
+
 // Dfv returns the client data format version of the connector.
 func (c *Connector) Dfv() int { c.mu.RLock(); defer c.mu.RUnlock(); return c._dfv }
 
@@ -761,6 +945,145 @@ func (c *Connector) SetEmptyDateAsNull(emptyDateAsNull bool) {
 	c._emptyDateAsNull = emptyDateAsNull
 }
 
+/*
+Compression returns true if network compression is requested by the connector.
+
+If the database server does not support or declines compression, messages are sent and received uncompressed.
+*/
+func (c *Connector) Compression() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._compression
+}
+
+// SetCompression sets the network compression flag of the connector.
+func (c *Connector) SetCompression(compression bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._compression = compression
+}
+
+// XA returns true if X/Open XA transactions are enabled for connections of the connector.
+func (c *Connector) XA() bool { c.mu.RLock(); defer c.mu.RUnlock(); return c._xa }
+
+/*
+SetXA enables or disables X/Open XA transactions (see XAConn) for connections of the connector.
+
+Only if enabled, the client announces X/Open XA protocol support to the database server on connect.
+*/
+func (c *Connector) SetXA(xa bool) { c.mu.Lock(); defer c.mu.Unlock(); c._xa = xa }
+
+/*
+StatementRouting returns true if client-side statement routing is enabled.
+
+In scale-out systems the connector keeps additional connections to the database hosts reported in the
+topology information and executes prepared statements on the host the data is located at. Statements executed
+within a transaction are always executed on the connection the transaction was started on.
+*/
+func (c *Connector) StatementRouting() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._statementRouting
+}
+
+// SetStatementRouting sets the client-side statement routing flag of the connector.
+func (c *Connector) SetStatementRouting(statementRouting bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._statementRouting = statementRouting
+}
+
+/*
+CancelSession returns true if the non-destructive statement cancellation is enabled.
+
+By default a statement execution canceled via context invalidates the connection. If enabled, the driver
+cancels the statement on the database server via an additional connection (ALTER SYSTEM CANCEL SESSION)
+and reads the statement reply, so that the connection can be reused. The database user needs the
+SESSION ADMIN privilege to cancel its own sessions.
+If the statement cannot be canceled on the database server within a short time budget (2 seconds), the connection
+is invalidated. As the cancellation might roll back an open transaction, a connection canceled within a transaction
+is invalidated as well, so that the transaction cannot be committed.
+A canceled call returns the context error in any case, even if the statement finished before the cancellation
+took effect.
+*/
+func (c *Connector) CancelSession() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._cancelSession
+}
+
+// SetCancelSession sets the non-destructive statement cancellation flag of the connector.
+func (c *Connector) SetCancelSession(cancelSession bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._cancelSession = cancelSession
+}
+
+/*
+LoadBalancing returns the host selection policy of a multi-host connector.
+
+A multi-host connector is created by providing more than one host separated by comma
+(e.g. "host1:30015,host2:30015"). With LbFailover the hosts are tried in the given order, with LbRoundRobin
+the first host to be tried is rotated for each new connection. In both cases the next host is tried if a
+connection to a host cannot be established.
+*/
+func (c *Connector) LoadBalancing() LoadBalancing {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._loadBalancing
+}
+
+// SetLoadBalancing sets the host selection policy of a multi-host connector.
+func (c *Connector) SetLoadBalancing(loadBalancing LoadBalancing) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._loadBalancing = loadBalancing
+}
+
+/*
+BlacklistPeriod returns the blacklist period of a multi-host connector.
+
+A host a connection cannot be established to is blacklisted for the blacklist period. Blacklisted hosts
+are tried only after all other hosts failed. A blacklist period of zero disables blacklisting.
+Each connector keeps its own blacklist.
+*/
+func (c *Connector) BlacklistPeriod() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._blacklistPeriod
+}
+
+// SetBlacklistPeriod sets the blacklist period of a multi-host connector.
+func (c *Connector) SetBlacklistPeriod(blacklistPeriod time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._blacklistPeriod = max(blacklistPeriod, 0)
+}
+
+/*
+ReadOnlyRouting returns true if the routing of read-only transactions is enabled.
+
+In an Active/Active (read enabled) system replication the connector opens an additional connection to the
+secondary site and executes read-only transactions (sql.TxOptions.ReadOnly) on the secondary site. All other
+statements are executed on the primary site. If the secondary site is not available, read-only transactions are
+executed on the primary site. The number of transactions routed to the secondary site and the number of fallbacks
+to the primary site are reported in Stats.
+Statements prepared outside of a read-only transaction routed to the secondary site are prepared again on the
+secondary site when executed within the transaction and vice versa.
+*/
+func (c *Connector) ReadOnlyRouting() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._readOnlyRouting
+}
+
+// SetReadOnlyRouting sets the read-only transaction routing flag of the connector.
+func (c *Connector) SetReadOnlyRouting(readOnlyRouting bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._readOnlyRouting = readOnlyRouting
+}
+
 // Logger returns the Logger instance of the connector.
 func (c *Connector) Logger() *slog.Logger {
 	c.mu.RLock()
@@ -779,16 +1102,20 @@ func (c *Connector) SetLogger(logger *slog.Logger) {
 }
 
 // auth attributes.
-func (c *Connector) cookieAuth() *p.AuthHnd {
-	if !c.hasCookie.Load() { // fastpath without lock
+func (c *Connector) cookieAuth(ctx context.Context) *p.AuthHnd {
+	if !c.hasCookie.Load() && !c.loadCookie(ctx) { // fastpath without lock
 		return nil
 	}
 
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	auth := p.NewAuthHnd(c._logonname)                              // important: for session cookie auth we do need the logonname from JWT auth,
-	auth.AddSessionCookie(c._sessionCookie, c._logonname, clientID) // and for HANA onPrem the final session cookie req needs the logonname as well.
+	if !authMethodAllowed(auth.MtSessionCookie, c._authMethods, c._excludedAuthMethods) {
+		return nil
+	}
+
+	auth := p.NewAuthHnd(c._logonname)                                       // important: for session cookie auth we do need the logonname from JWT or SAML auth,
+	auth.AddSessionCookie(c._sessionCookie, c._logonname, c._cookieClientID) // and for HANA onPrem the final session cookie req needs the logonname as well.
 	return auth
 }
 
@@ -803,19 +1130,31 @@ func (c *Connector) authHnd() *p.AuthHnd {
 	if c._token != "" {
 		authHnd.AddJWT(c._token)
 	}
+	if c._assertion != "" {
+		authHnd.AddSAML(c._assertion)
+	}
 	// mimic standard drivers and use password as token if user is empty
 	if c._token == "" && c._username == "" && isJWTToken(c._password) {
 		authHnd.AddJWT(c._password)
 	}
 	if c._password != "" {
-		authHnd.AddBasic(c._username, c._password)
+		authHnd.AddBasic(c._username, c._password, pbkdf2Rounds(c._pbkdf2MinRounds), pbkdf2Rounds(c._pbkdf2MaxRounds))
 		authHnd.AddLDAP(c._username, c._password)
 	}
+	for _, fn := range c._authMethodFns {
+		authHnd.AddMethod(newAuthMethod(fn()))
+	}
+	if len(c._authMethods) != 0 || len(c._excludedAuthMethods) != 0 {
+		authHnd.Restrict(func(mt string) bool { return authMethodAllowed(mt, c._authMethods, c._excludedAuthMethods) })
+	}
 	return authHnd
 }
 
-func (c *Connector) refresh() (bool, error) {
-	refreshed := false
+func (c *Connector) refresh(ctx context.Context) (bool, error) {
+	refreshed, err := c.updateCredentials(ctx, true)
+	if err != nil {
+		return refreshed, err
+	}
 
 	callRefreshPassword := func(refreshPassword func() (string, bool)) (string, bool) {
 		defer c.mu.Lock() // finally lock attr again
@@ -829,6 +1168,12 @@ func (c *Connector) refresh() (bool, error) {
 		return refreshToken()
 	}
 
+	callRefreshAssertion := func(refreshAssertion func() (assertion string, ok bool)) (string, bool) {
+		defer c.mu.Lock() // finally lock attr again
+		c.mu.Unlock()     // unlock attr, so that callback can call attr methods
+		return refreshAssertion()
+	}
+
 	callRefreshClientCert := func(refreshClientCert func() (clientCert, clientKey []byte, ok bool)) (unique.Handle[string], unique.Handle[string], bool) {
 		var handle unique.Handle[string]
 		defer c.mu.Lock() // finally lock attr again
@@ -843,7 +1188,10 @@ func (c *Connector) refresh() (bool, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c._refreshPasswordFn != nil {
+	// a credential provider takes precedence over the refresh callbacks
+	callbacks := c._credentialProvider == nil
+
+	if callbacks && c._refreshPasswordFn != nil {
 		if password, ok := callRefreshPassword(c._refreshPasswordFn); ok {
 			if password != c._password {
 				c._password = password
@@ -851,7 +1199,7 @@ func (c *Connector) refresh() (bool, error) {
 			}
 		}
 	}
-	if c._refreshTokenFn != nil {
+	if callbacks && c._refreshTokenFn != nil {
 		if token, ok := callRefreshToken(c._refreshTokenFn); ok {
 			if token != c._token {
 				c._token = token
@@ -859,10 +1207,18 @@ func (c *Connector) refresh() (bool, error) {
 			}
 		}
 	}
-	if c._refreshClientCertFn != nil {
+	if callbacks && c._refreshAssertionFn != nil {
+		if assertion, ok := callRefreshAssertion(c._refreshAssertionFn); ok {
+			if assertion != c._assertion {
+				c._assertion = assertion
+				refreshed = true
+			}
+		}
+	}
+	if callbacks && c._refreshClientCertFn != nil {
 		if certHandle, keyHandle, ok := callRefreshClientCert(c._refreshClientCertFn); ok {
 			if c._certKey == nil || !c._certKey.Equal(certHandle, keyHandle) {
-				certKey, err := auth.NewCertKey(certHandle, keyHandle)
+				certKey, err := auth.NewCertKey(certHandle, keyHandle, c._keyPassword)
 				if err != nil {
 					return refreshed, err
 				}
@@ -870,10 +1226,10 @@ func (c *Connector) refresh() (bool, error) {
 				refreshed = true
 			}
 		}
-	} else if c._certFile != "" && c._keyFile != "" {
+	} else if c._certFile != "" {
 		if certHandle, keyHandle, err := readCertKeyFiles(c._certFile, c._keyFile); err == nil {
 			if c._certKey == nil || !c._certKey.Equal(certHandle, keyHandle) {
-				certKey, err := auth.NewCertKey(certHandle, keyHandle)
+				certKey, err := auth.NewCertKey(certHandle, keyHandle, c._keyPassword)
 				if err != nil {
 					return refreshed, err
 				}
@@ -885,14 +1241,78 @@ func (c *Connector) refresh() (bool, error) {
 	return refreshed, nil
 }
 
-func (c *Connector) invalidateCookie() { c.hasCookie.Store(false) }
+func (c *Connector) invalidateCookie(ctx context.Context) {
+	c.hasCookie.Store(false)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c._cookieStore == nil {
+		return
+	}
+	if err := c._cookieStore.Invalidate(c.cookieStoreKey()); err != nil {
+		c._logger.LogAttrs(ctx, slog.LevelWarn, "cannot invalidate stored session cookie", slog.Any("error", err))
+	}
+}
 
-func (c *Connector) setCookie(logonname string, sessionCookie []byte) {
+func (c *Connector) setCookie(ctx context.Context, logonname string, sessionCookie []byte) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	c.hasCookie.Store(true)
 	c._logonname = logonname
 	c._sessionCookie = sessionCookie
+	c._cookieClientID = clientID
+	if c._cookieStore == nil {
+		return
+	}
+	if err := c._cookieStore.Save(c.cookieStoreKey(), &SessionCookie{Logonname: logonname, ClientID: clientID, Cookie: sessionCookie}); err != nil {
+		c._logger.LogAttrs(ctx, slog.LevelWarn, "cannot store session cookie", slog.Any("error", err))
+	}
+}
+
+// loadCookie loads the session cookie from the cookie store and returns true if a session cookie was found.
+func (c *Connector) loadCookie(ctx context.Context) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c._cookieStore == nil || !authMethodAllowed(auth.MtSessionCookie, c._authMethods, c._excludedAuthMethods) {
+		return false
+	}
+	cookie, err := c._cookieStore.Load(c.cookieStoreKey())
+	if err != nil {
+		c._logger.LogAttrs(ctx, slog.LevelWarn, "cannot load stored session cookie", slog.Any("error", err))
+		return false
+	}
+	if cookie == nil {
+		return false
+	}
+	c.hasCookie.Store(true)
+	c._logonname = cookie.Logonname
+	c._sessionCookie = cookie.Cookie
+	c._cookieClientID = cookie.ClientID
+	return true
+}
+
+func (c *Connector) cookieStoreKey() string {
+	token := c._token
+	if token == "" && c._username == "" && isJWTToken(c._password) { // password used as token
+		token = c._password
+	}
+	return cookieStoreKey(c._host, c._databaseName, c._username, token, c._assertion)
+}
+
+// CookieStore returns the session cookie store of the connector.
+func (c *Connector) CookieStore() CookieStore {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._cookieStore
+}
+
+// SetCookieStore sets the session cookie store of the connector (see NewFileCookieStore).
+// Session cookies of successful JWT or SAML authentications are saved in the store and reused by other connectors
+// and processes using the same store.
+func (c *Connector) SetCookieStore(cookieStore CookieStore) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._cookieStore = cookieStore
 }
 
 // Username returns the username of the connector.
@@ -917,7 +1337,8 @@ func (c *Connector) RefreshPassword() func() (password string, ok bool) {
 
 // SetRefreshPassword sets the callback function for basic authentication password refresh.
 // The callback function might be called simultaneously from multiple goroutines only if registered
-// for more than one Connector.
+// for more than one Connector. The callback function is not called if a credential provider is set
+// (see SetCredentialProvider).
 func (c *Connector) SetRefreshPassword(refreshPasswordFn func() (password string, ok bool)) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -925,6 +1346,7 @@ func (c *Connector) SetRefreshPassword(refreshPasswordFn func() (password string
 }
 
 // ClientCert returns the X509 authentication client certificate and key of the connector.
+// In case the client key is held by a crypto.Signer the client certificate chain is returned in PEM format and the key is nil.
 func (c *Connector) ClientCert() (clientCert, clientKey []byte) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -934,6 +1356,21 @@ func (c *Connector) ClientCert() (clientCert, clientKey []byte) {
 	return c._certKey.Cert(), c._certKey.Key()
 }
 
+// ClientKeyPassword returns the password of encrypted X509 authentication client keys and PKCS#12 bundles.
+func (c *Connector) ClientKeyPassword() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._keyPassword
+}
+
+// SetClientKeyPassword sets the password of encrypted X509 authentication client keys and PKCS#12 bundles
+// returned by the client certificate refresh callback function.
+func (c *Connector) SetClientKeyPassword(password string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._keyPassword = password
+}
+
 // RefreshClientCert returns the callback function for X509 authentication client certificate and key refresh.
 func (c *Connector) RefreshClientCert() func() (clientCert, clientKey []byte, ok bool) {
 	c.mu.RLock()
@@ -942,14 +1379,83 @@ func (c *Connector) RefreshClientCert() func() (clientCert, clientKey []byte, ok
 }
 
 // SetRefreshClientCert sets the callback function for X509 authentication client certificate and key refresh.
+// The callback function might return password encrypted keys and PKCS#12 bundles (see NewX509AuthConnectorWithPassword
+// and SetClientKeyPassword).
 // The callback function might be called simultaneously from multiple goroutines only if registered
-// for more than one Connector.
+// for more than one Connector. The callback function is not called if a credential provider is set
+// (see SetCredentialProvider).
 func (c *Connector) SetRefreshClientCert(refreshClientCertFn func() (clientCert, clientKey []byte, ok bool)) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	c._refreshClientCertFn = refreshClientCertFn
 }
 
+/*
+RegisterAuthMethod registers an additional authentication method.
+
+The function newMethod is called for each authentication attempt and needs to return a new AuthMethod instance,
+as authentication methods keep the state of the authentication exchange. A registered method with the type of a
+built-in authentication method replaces the built-in method.
+*/
+func (c *Connector) RegisterAuthMethod(newMethod func() AuthMethod) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._authMethodFns = append(c._authMethodFns, newMethod)
+}
+
+// AuthMethods returns the authentication method types the connector is restricted to.
+func (c *Connector) AuthMethods() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return slices.Clone(c._authMethods)
+}
+
+/*
+SetAuthMethods restricts the authentication methods offered to the database server to the given method types
+(see AuthTypeSCRAMPBKDF2SHA256, ...), e.g. to allow password authentication via SCRAMPBKDF2SHA256 only.
+Calling SetAuthMethods without method types removes the restriction.
+*/
+func (c *Connector) SetAuthMethods(methods ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._authMethods = slices.Clone(methods)
+}
+
+// ExcludedAuthMethods returns the authentication method types which are never offered to the database server.
+func (c *Connector) ExcludedAuthMethods() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return slices.Clone(c._excludedAuthMethods)
+}
+
+// SetExcludedAuthMethods sets the authentication method types which are never offered to the database server,
+// e.g. AuthTypeSCRAMSHA256 to prevent password authentication without PBKDF2 key derivation.
+func (c *Connector) SetExcludedAuthMethods(methods ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._excludedAuthMethods = slices.Clone(methods)
+}
+
+// PBKDF2Rounds returns the minimum and maximum number of PBKDF2 rounds accepted for SCRAMPBKDF2SHA256 authentication.
+func (c *Connector) PBKDF2Rounds() (minRounds, maxRounds int) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._pbkdf2MinRounds, c._pbkdf2MaxRounds
+}
+
+/*
+SetPBKDF2Rounds sets the minimum and maximum number of PBKDF2 rounds accepted for SCRAMPBKDF2SHA256 authentication.
+
+The authentication is cancelled on client side if the number of rounds requested by the database server is
+outside the limits. A value of zero means no limit.
+*/
+func (c *Connector) SetPBKDF2Rounds(minRounds, maxRounds int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._pbkdf2MinRounds = max(minRounds, 0)
+	c._pbkdf2MaxRounds = max(maxRounds, 0)
+}
+
 // Token returns the JWT authentication token of the connector.
 func (c *Connector) Token() string { c.mu.RLock(); defer c.mu.RUnlock(); return c._token }
 
@@ -962,9 +1468,76 @@ func (c *Connector) RefreshToken() func() (token string, ok bool) {
 
 // SetRefreshToken sets the callback function for JWT authentication token refresh.
 // The callback function might be called simultaneously from multiple goroutines only if registered
-// for more than one Connector.
+// for more than one Connector. The callback function is not called if a credential provider or
+// token source is set (see SetCredentialProvider and SetTokenSource).
 func (c *Connector) SetRefreshToken(refreshTokenFn func() (token string, ok bool)) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	c._refreshTokenFn = refreshTokenFn
 }
+
+// CredentialProvider returns the credential provider of the connector.
+func (c *Connector) CredentialProvider() CredentialProvider {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._credentialProvider
+}
+
+/*
+SetCredentialProvider sets the credential provider of the connector.
+
+The credentials returned by the provider are cached by the connector and are refreshed before they expire
+and after an authentication error. In contrast to the refresh callbacks (SetRefreshPassword, SetRefreshToken,
+SetRefreshClientCert and SetRefreshAssertion) the provider receives the context of the connection attempt.
+If both are set, the provider takes precedence and the refresh callbacks are not called.
+The provider might be called simultaneously from multiple goroutines only if registered for more than one Connector.
+*/
+func (c *Connector) SetCredentialProvider(provider CredentialProvider) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._credentialProvider = provider
+	c.credentials = nil
+}
+
+// TokenSource returns the JWT authentication token source of the connector.
+func (c *Connector) TokenSource() TokenSource {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if p, ok := c._credentialProvider.(*tokenSourceProvider); ok {
+		return p.ts
+	}
+	return nil
+}
+
+/*
+SetTokenSource sets the token source for JWT authentication (see NewClientCredentialsTokenSource).
+
+Tokens are fetched, cached and refreshed before they expire by the connector. The token source
+is set as credential provider of the connector and replaces a provider set via SetCredentialProvider.
+*/
+func (c *Connector) SetTokenSource(ts TokenSource) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._credentialProvider = &tokenSourceProvider{ts: ts}
+	c.credentials = nil
+}
+
+// Assertion returns the SAML authentication bearer assertion of the connector.
+func (c *Connector) Assertion() string { c.mu.RLock(); defer c.mu.RUnlock(); return c._assertion }
+
+// RefreshAssertion returns the callback function for SAML authentication bearer assertion refresh.
+func (c *Connector) RefreshAssertion() func() (assertion string, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._refreshAssertionFn
+}
+
+// SetRefreshAssertion sets the callback function for SAML authentication bearer assertion refresh.
+// The callback function might be called simultaneously from multiple goroutines only if registered
+// for more than one Connector. The callback function is not called if a credential provider is set
+// (see SetCredentialProvider).
+func (c *Connector) SetRefreshAssertion(refreshAssertionFn func() (assertion string, ok bool)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._refreshAssertionFn = refreshAssertionFn
+}
@@ -3,6 +3,7 @@
 package driver
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"sync"
@@ -135,6 +136,7 @@ func TestConnector(t *testing.T) {
 
 		ctr.SetRefreshPassword(func() (string, bool) { return "", true })
 		ctr.SetRefreshToken(func() (string, bool) { return "", true })
+		ctr.SetRefreshAssertion(func() (string, bool) { return "", true })
 		ctr.SetRefreshClientCert(func() ([]byte, []byte, bool) { return nil, nil, true })
 
 		wg := new(sync.WaitGroup)
@@ -142,7 +144,7 @@ func TestConnector(t *testing.T) {
 		for range numConcurrent {
 			wg.Go(func() {
 				<-start
-				ctr.refresh() //nolint:errcheck
+				ctr.refresh(context.Background()) //nolint:errcheck
 			})
 		}
 		// start refresh concurrently
@@ -0,0 +1,122 @@
+package driver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SessionCookie is a session cookie returned by the database server after a successful JWT or SAML authentication.
+type SessionCookie struct {
+	Logonname string
+	ClientID  string // client id the session cookie was created for
+	Cookie    []byte
+}
+
+/*
+CookieStore is the interface of a session cookie store.
+
+A cookie store enables the reuse of session cookies across processes, e.g. for short-lived workers authenticating
+via JWT. The key identifies the database (host and database name) and the user of the connector. As the user is not
+known in case of JWT or SAML authentication, the identity is a hash of the complete token or assertion. The claims of
+a token or assertion are not used, as they are not verified by the driver. Therefore a session cookie is only reused
+for the very same token or assertion.
+
+Load returns nil and no error if no session cookie is stored for the key. Invalidate is called after an unsuccessful
+session cookie authentication.
+*/
+type CookieStore interface {
+	Load(key string) (*SessionCookie, error)
+	Save(key string, cookie *SessionCookie) error
+	Invalidate(key string) error
+}
+
+// cookieStoreKey returns the cookie store key of the connector attributes.
+func cookieStoreKey(host, databaseName, username, token, assertion string) string {
+	identity := username
+	switch {
+	case identity != "":
+	case token != "":
+		identity = "jwt:" + hashIdentity(token)
+	case assertion != "":
+		identity = "saml:" + hashIdentity(assertion)
+	}
+	return strings.Join([]string{host, databaseName, identity}, "/")
+}
+
+func hashIdentity(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+// FileCookieStore is a CookieStore storing session cookies as files in a directory.
+type FileCookieStore struct {
+	dir string
+}
+
+var _ CookieStore = (*FileCookieStore)(nil)
+
+// NewFileCookieStore returns a new FileCookieStore instance. The directory is created if it does not exist.
+func NewFileCookieStore(dir string) (*FileCookieStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileCookieStore{dir: dir}, nil
+}
+
+func (s *FileCookieStore) file(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(h[:])+".cookie")
+}
+
+// Load implements the CookieStore interface.
+func (s *FileCookieStore) Load(key string) (*SessionCookie, error) {
+	b, err := os.ReadFile(s.file(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil //nolint:nilnil // no cookie stored
+	}
+	if err != nil {
+		return nil, err
+	}
+	cookie := &SessionCookie{}
+	if err := json.Unmarshal(b, cookie); err != nil {
+		return nil, err
+	}
+	return cookie, nil
+}
+
+// Save implements the CookieStore interface.
+func (s *FileCookieStore) Save(key string, cookie *SessionCookie) error {
+	b, err := json.Marshal(cookie)
+	if err != nil {
+		return err
+	}
+	// write to temporary file and rename to prevent concurrent readers from reading incomplete files.
+	f, err := os.CreateTemp(s.dir, "*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), s.file(key))
+}
+
+// Invalidate implements the CookieStore interface.
+func (s *FileCookieStore) Invalidate(key string) error {
+	if err := os.Remove(s.file(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package driver
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func TestFileCookieStore(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewFileCookieStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c1 := NewJWTAuthConnector("host:30015", "eyToken")
+	c1.SetCookieStore(store)
+	if c1.cookieAuth(ctx) != nil {
+		t.Fatal("unexpected session cookie authentication")
+	}
+	c1.setCookie(ctx, "USER123", []byte("cookie"))
+
+	// second connector (e.g. after process restart) reuses the stored session cookie
+	c2 := NewJWTAuthConnector("host:30015", "eyToken")
+	c2.SetCookieStore(store)
+	if c2.cookieAuth(ctx) == nil {
+		t.Fatal("session cookie not loaded from store")
+	}
+	if c2._logonname != "USER123" || !bytes.Equal(c2._sessionCookie, []byte("cookie")) || c2._cookieClientID != clientID {
+		t.Fatalf("logonname %s cookie %s client id %s - expected USER123 cookie %s", c2._logonname, c2._sessionCookie, c2._cookieClientID, clientID)
+	}
+
+	// different database does not share the session cookie
+	c3 := c1.WithDatabase("TENANT")
+	if c3.cookieAuth(ctx) != nil {
+		t.Fatal("unexpected session cookie authentication")
+	}
+
+	// invalidation removes the session cookie from the store
+	c2.invalidateCookie(ctx)
+	cookie, err := store.Load(c1.cookieStoreKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cookie != nil {
+		t.Fatal("session cookie not invalidated")
+	}
+	if err := store.Invalidate(c1.cookieStoreKey()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCookieAuthMethodRestriction(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewFileCookieStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewJWTAuthConnector("host:30015", "eyToken")
+	c.SetCookieStore(store)
+	c.setCookie(ctx, "USER123", []byte("cookie"))
+	if c.cookieAuth(ctx) == nil {
+		t.Fatal("session cookie authentication expected")
+	}
+
+	c.SetExcludedAuthMethods(AuthTypeSessionCookie)
+	if c.cookieAuth(ctx) != nil {
+		t.Fatal("unexpected session cookie authentication for excluded method")
+	}
+	c.SetExcludedAuthMethods()
+
+	c.SetAuthMethods(AuthTypeJWT)
+	if c.cookieAuth(ctx) != nil {
+		t.Fatal("unexpected session cookie authentication for restricted methods")
+	}
+
+	// stored session cookie is not loaded if the method is not allowed
+	c2 := NewJWTAuthConnector("host:30015", "eyToken")
+	c2.SetCookieStore(store)
+	c2.SetAuthMethods(AuthTypeJWT)
+	if c2.cookieAuth(ctx) != nil || c2.hasCookie.Load() {
+		t.Fatal("unexpected session cookie authentication for restricted methods")
+	}
+}
+
+func TestCookieStoreKey(t *testing.T) {
+	tests := []struct {
+		name                   string
+		token1, token2         string
+		assertion1, assertion2 string
+		equal                  bool
+	}{
+		{"jwt same token", "eyToken1", "eyToken1", "", "", true},
+		{"jwt different token", "eyToken1", "eyToken2", "", "", false},
+		// unverified claims (same issuer and subject) must not share a session cookie
+		{"jwt same claims", "eyHeader.eyClaims.signature1", "eyHeader.eyClaims.signature2", "", "", false},
+		{"saml same assertion", "", "", "assertion1", "assertion1", true},
+		{"saml different assertion", "", "", "assertion1", "assertion2", false},
+		{"jwt and saml", "token", "", "", "token", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key1 := cookieStoreKey("host:30015", "", "", test.token1, test.assertion1)
+			key2 := cookieStoreKey("host:30015", "", "", test.token2, test.assertion2)
+			if (key1 == key2) != test.equal {
+				t.Fatalf("key %s key %s equal %t - expected %t", key1, key2, key1 == key2, test.equal)
+			}
+		})
+	}
+}
+
+func TestCookieStoreCredentialProvider(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewFileCookieStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := CredentialProviderFunc(func(ctx context.Context) (*Credentials, error) {
+		return &Credentials{Token: "eyToken"}, nil
+	})
+
+	c1 := NewJWTAuthConnector("host:30015", "")
+	c1.SetCookieStore(store)
+	c1.SetCredentialProvider(provider)
+	if _, err := c1.updateCredentials(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	c1.setCookie(ctx, "USER123", []byte("cookie"))
+
+	// the stored session cookie is found only after the token is fetched from the provider
+	c2 := NewJWTAuthConnector("host:30015", "")
+	c2.SetCookieStore(store)
+	c2.SetCredentialProvider(provider)
+	if c2.cookieAuth(ctx) != nil {
+		t.Fatal("unexpected session cookie authentication without token")
+	}
+	if _, err := c2.updateCredentials(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	if c2.cookieAuth(ctx) == nil {
+		t.Fatal("session cookie not loaded from store")
+	}
+}
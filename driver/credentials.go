@@ -0,0 +1,122 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unique"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/auth"
+)
+
+// credentialExpiryDelta is the period before the expiry of credentials in which they are refreshed proactively.
+const credentialExpiryDelta = 30 * time.Second
+
+/*
+Credentials are the authentication credentials returned by a CredentialProvider.
+
+Only non-empty fields are applied to the connector, so that a provider might e.g. only return a token
+or a password. ClientCert and ClientKey might be password encrypted keys or PKCS#12 bundles (see SetClientKeyPassword).
+A zero Expiry time means that the credentials do not expire.
+*/
+type Credentials struct {
+	Username   string
+	Password   string
+	Token      string // JWT
+	Assertion  string // SAML
+	ClientCert []byte
+	ClientKey  []byte
+	Expiry     time.Time
+}
+
+// expires returns true if the expiry time is reached or within the credential expiry delta.
+func expires(expiry, now time.Time) bool {
+	return !expiry.IsZero() && !now.Add(credentialExpiryDelta).Before(expiry)
+}
+
+func (c *Credentials) expires(now time.Time) bool { return expires(c.Expiry, now) }
+
+/*
+CredentialProvider is the interface of a provider of authentication credentials.
+
+The connector calls Credentials
+  - before the first connection is established,
+  - before the cached credentials expire and
+  - after an authentication error.
+
+The context is the context of the connection attempt, so that providers backed by e.g. a secret store or an
+OAuth token endpoint can block and are cancelled together with the connection attempt.
+Credentials is not called in parallel for the same Connector.
+*/
+type CredentialProvider interface {
+	Credentials(ctx context.Context) (*Credentials, error)
+}
+
+// CredentialProviderFunc is an adapter to use ordinary functions as CredentialProvider.
+type CredentialProviderFunc func(ctx context.Context) (*Credentials, error)
+
+// Credentials implements the CredentialProvider interface.
+func (f CredentialProviderFunc) Credentials(ctx context.Context) (*Credentials, error) { return f(ctx) }
+
+// invalidator is implemented by credential providers and token sources caching credentials.
+type invalidator interface {
+	invalidate()
+}
+
+// updateCredentials fetches credentials from the credential provider in case the cached credentials are expiring
+// or force is set and applies them. In case of force cached credentials of the provider are invalidated. It needs to be called with the callback mutex cbmu held.
+func (c *Connector) updateCredentials(ctx context.Context, force bool) (bool, error) {
+	c.mu.RLock()
+	provider, credentials := c._credentialProvider, c.credentials
+	c.mu.RUnlock()
+
+	if provider == nil {
+		return false, nil
+	}
+	if !force && credentials != nil && !credentials.expires(time.Now()) {
+		return false, nil
+	}
+	if inv, ok := provider.(invalidator); force && ok {
+		inv.invalidate()
+	}
+	credentials, err := provider.Credentials(ctx) // call provider without holding the attribute lock
+	if err != nil {
+		return false, err
+	}
+	if credentials == nil {
+		return false, errors.New("credential provider did not return credentials")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.credentials = credentials
+	return c.setCredentials(credentials)
+}
+
+// setCredentials applies the non-empty credential fields and returns true if at least one of the fields did change.
+func (c *Connector) setCredentials(credentials *Credentials) (bool, error) {
+	changed := false
+	set := func(v *string, s string) {
+		if s != "" && s != *v {
+			*v = s
+			changed = true
+		}
+	}
+	set(&c._username, credentials.Username)
+	set(&c._password, credentials.Password)
+	set(&c._token, credentials.Token)
+	set(&c._assertion, credentials.Assertion)
+
+	if credentials.ClientCert != nil {
+		certHandle, keyHandle := unique.Make(string(credentials.ClientCert)), unique.Make(string(credentials.ClientKey))
+		if c._certKey == nil || !c._certKey.Equal(certHandle, keyHandle) {
+			certKey, err := auth.NewCertKey(certHandle, keyHandle, c._keyPassword)
+			if err != nil {
+				return changed, err
+			}
+			c._certKey = certKey
+			changed = true
+		}
+	}
+	return changed, nil
+}
@@ -0,0 +1,85 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCredentialProvider(t *testing.T) {
+	ctx := context.Background()
+
+	calls := 0
+	credentials := &Credentials{Username: "user", Password: "password1"}
+	c := NewBasicAuthConnector("host:30015", "", "")
+	c.SetCredentialProvider(CredentialProviderFunc(func(ctx context.Context) (*Credentials, error) {
+		calls++
+		return credentials, ctx.Err()
+	}))
+
+	// initial fetch
+	if changed, err := c.updateCredentials(ctx, false); err != nil || !changed {
+		t.Fatalf("changed %t error %v - expected changed credentials", changed, err)
+	}
+	if c.Username() != "user" || c.Password() != "password1" {
+		t.Fatalf("username %s password %s - expected user password1", c.Username(), c.Password())
+	}
+
+	// cached credentials without expiry
+	if _, err := c.updateCredentials(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Fatalf("calls %d - expected 1", calls)
+	}
+
+	// expiring credentials are refreshed proactively
+	credentials = &Credentials{Password: "password2", Expiry: time.Now().Add(credentialExpiryDelta / 2)}
+	if _, err := c.updateCredentials(ctx, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.updateCredentials(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Fatalf("calls %d - expected 3", calls)
+	}
+	if c.Username() != "user" || c.Password() != "password2" {
+		t.Fatalf("username %s password %s - expected user password2", c.Username(), c.Password())
+	}
+
+	// unchanged credentials
+	if changed, err := c.updateCredentials(ctx, true); err != nil || changed {
+		t.Fatalf("changed %t error %v - expected unchanged credentials", changed, err)
+	}
+
+	// cancelled context
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := c.refresh(cancelCtx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("error %v - expected %v", err, context.Canceled)
+	}
+}
+
+func TestCredentialProviderPrecedence(t *testing.T) {
+	c := NewBasicAuthConnector("host:30015", "user", "password")
+	c.SetRefreshPassword(func() (string, bool) { return "callback", true })
+	c.SetCredentialProvider(CredentialProviderFunc(func(ctx context.Context) (*Credentials, error) {
+		return &Credentials{Password: "provider"}, nil
+	}))
+	if _, err := c.refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if c.Password() != "provider" {
+		t.Fatalf("password %s - expected %s", c.Password(), "provider")
+	}
+
+	c.SetCredentialProvider(nil)
+	if _, err := c.refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if c.Password() != "callback" {
+		t.Fatalf("password %s - expected %s", c.Password(), "callback")
+	}
+}
@@ -10,7 +10,7 @@ import (
 )
 
 // DriverVersion is the version number of the hdb driver.
-const DriverVersion = "1.16.7"
+const DriverVersion = "1.17.0"
 
 // DriverName is the driver name to use with sql.Open for hdb databases.
 const DriverName = "hdb"
@@ -40,7 +40,7 @@ func register() {
 		panic(err) // invalid configuration file
 	}
 	// create driver
-	stdHdbDriver = &hdbDriver{metrics: newMetrics(nil, statsCfg.TimeUnit, statsCfg.TimeUpperBounds)}
+	stdHdbDriver = &hdbDriver{metrics: newMetrics(nil, statsCfg.TimeUnit, statsCfg.TimeUpperBounds, statsCfg.MemoryUnit, statsCfg.MemoryUpperBounds)}
 	// register driver
 	sql.Register(DriverName, stdHdbDriver)
 }
@@ -110,7 +110,7 @@ type DB struct {
 
 // OpenDB opens and returns a database. It also calls the OpenDB method of the sql package and stores an embedded *sql.DB object.
 func OpenDB(c *Connector) *DB {
-	metrics := newMetrics(stdHdbDriver.metrics, statsCfg.TimeUnit, statsCfg.TimeUpperBounds)
+	metrics := newMetrics(stdHdbDriver.metrics, statsCfg.TimeUnit, statsCfg.TimeUpperBounds, statsCfg.MemoryUnit, statsCfg.MemoryUpperBounds)
 	nc := c.clone()
 	nc.metrics = metrics
 	return &DB{
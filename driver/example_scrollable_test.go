@@ -0,0 +1,88 @@
+//go:build !unit
+
+package driver_test
+
+import (
+	"context"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"fmt"
+	"log"
+
+	"github.com/SAP/go-hdb/driver"
+)
+
+// ExampleWithScrollableCursor demonstrates how to position a query resultset using a scrollable cursor.
+func ExampleWithScrollableCursor() {
+	db := sql.OpenDB(driver.MT.Connector())
+	defer db.Close()
+
+	table := driver.RandomIdentifier("scrollable_")
+
+	if _, err := db.Exec(fmt.Sprintf("create column table %s (i integer)", table)); err != nil {
+		log.Fatal(err)
+	}
+	for i := 1; i <= 10; i++ {
+		if _, err := db.Exec(fmt.Sprintf("insert into %s values (?)", table), i); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer conn.Close()
+
+	// Database/sql does not provide access to the driver rows - so execute the query on the driver connection.
+	if err := conn.Raw(func(driverConn any) error {
+		rows, err := driverConn.(sqldriver.QueryerContext).QueryContext(driver.WithScrollableCursor(ctx), fmt.Sprintf("select i from %s order by i", table), nil)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		scrollableRows := rows.(driver.ScrollableRows)
+		dest := make([]sqldriver.Value, 1)
+
+		next := func() error {
+			if err := scrollableRows.Next(dest); err != nil {
+				return err
+			}
+			fmt.Println(dest[0])
+			return nil
+		}
+
+		if err := scrollableRows.Absolute(5); err != nil {
+			return err
+		}
+		if err := next(); err != nil { // row 5
+			return err
+		}
+		if err := scrollableRows.Relative(-3); err != nil {
+			return err
+		}
+		if err := next(); err != nil { // row 3
+			return err
+		}
+		if err := scrollableRows.Last(); err != nil {
+			return err
+		}
+		if err := next(); err != nil { // row 10
+			return err
+		}
+		if err := scrollableRows.First(); err != nil {
+			return err
+		}
+		return next() // row 1
+	}); err != nil {
+		log.Fatal(err)
+	}
+
+	// output: 5
+	// 3
+	// 10
+	// 1
+}
@@ -0,0 +1,28 @@
+//go:build !unit
+
+package driver_test
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/SAP/go-hdb/driver"
+)
+
+// ExampleWithServerStmtStats demonstrates how to retrieve the statement statistics reported by the database server.
+func ExampleWithServerStmtStats() {
+	db := sql.OpenDB(driver.MT.Connector())
+	defer db.Close()
+
+	var stats driver.ServerStmtStats
+	ctx := driver.WithServerStmtStats(context.Background(), &stats)
+
+	rows, err := db.QueryContext(ctx, "select * from dummy")
+	if err != nil {
+		log.Fatal(err)
+	}
+	rows.Close()
+
+	log.Printf("processing time %s cpu time %s memory usage %d bytes", stats.ProcessingTime, stats.CPUTime, stats.MemoryUsage)
+}
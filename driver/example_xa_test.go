@@ -0,0 +1,59 @@
+//go:build !unit
+
+package driver_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/SAP/go-hdb/driver"
+)
+
+// ExampleXAConn demonstrates how to execute a statement as part of a distributed (two-phase commit) transaction.
+func ExampleXAConn() {
+	connector := driver.MT.NewConnector()
+	connector.SetXA(true) // enable X/Open XA transactions
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	table := driver.RandomIdentifier("xa_")
+
+	if _, err := db.Exec(fmt.Sprintf("create column table %s (i integer)", table)); err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer conn.Close()
+
+	xid := &driver.XID{FormatID: 1, GlobalTransactionID: []byte(table), BranchQualifier: []byte("branch1")}
+
+	// xa executes f on the go-hdb driver connection.
+	xa := func(f func(xaConn driver.XAConn) error) {
+		if err := conn.Raw(func(driverConn any) error { return f(driverConn.(driver.XAConn)) }); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	xa(func(xaConn driver.XAConn) error { return xaConn.XAStart(ctx, xid, driver.XANoFlags) })
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("insert into %s values (?)", table), 1); err != nil {
+		log.Fatal(err)
+	}
+	xa(func(xaConn driver.XAConn) error { return xaConn.XAEnd(ctx, xid, driver.XASuccess) })
+	xa(func(xaConn driver.XAConn) error { return xaConn.XAPrepare(ctx, xid) })
+	xa(func(xaConn driver.XAConn) error { return xaConn.XACommit(ctx, xid, false) })
+
+	var i int
+	if err := db.QueryRow(fmt.Sprintf("select i from %s", table)).Scan(&i); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(i)
+
+	// output: 1
+}
@@ -0,0 +1,154 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LoadBalancing represents the host selection policy of a connector providing more than one host.
+type LoadBalancing int
+
+// LoadBalancing constants.
+const (
+	LbFailover   LoadBalancing = iota // Hosts are tried in the given order (default).
+	LbRoundRobin                      // Connections are distributed across the hosts in a round-robin manner.
+)
+
+const (
+	lbFailoverText   = "failover"
+	lbRoundRobinText = "roundRobin"
+)
+
+func (lb LoadBalancing) String() string {
+	switch lb {
+	case LbFailover:
+		return lbFailoverText
+	case LbRoundRobin:
+		return lbRoundRobinText
+	default:
+		return fmt.Sprintf("LoadBalancing(%d)", int(lb))
+	}
+}
+
+func parseLoadBalancing(s string) (LoadBalancing, bool) {
+	switch s {
+	case lbFailoverText:
+		return LbFailover, true
+	case lbRoundRobinText:
+		return LbRoundRobin, true
+	default:
+		return LbFailover, false
+	}
+}
+
+// hostSeparator separates the hosts of a multi-host connector.
+const hostSeparator = ","
+
+// defaultBlacklistPeriod is the default period an unreachable host is excluded from connection attempts.
+const defaultBlacklistPeriod = 60 * time.Second
+
+// splitHosts returns the hosts of a (comma separated) multi-host value.
+func splitHosts(host string) []string {
+	hosts := strings.Split(host, hostSeparator)
+	for i, host := range hosts {
+		hosts[i] = strings.TrimSpace(host)
+	}
+	return hosts
+}
+
+// hostBlacklist holds unreachable hosts together with the time until they are excluded from connection attempts.
+// The zero value is an empty blacklist.
+type hostBlacklist struct {
+	mu    sync.Mutex
+	hosts map[string]time.Time
+}
+
+func (b *hostBlacklist) add(host string, period time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.hosts == nil {
+		b.hosts = map[string]time.Time{}
+	}
+	b.hosts[host] = time.Now().Add(period)
+}
+
+func (b *hostBlacklist) remove(host string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.hosts, host)
+}
+
+func (b *hostBlacklist) contains(host string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	until, ok := b.hosts[host]
+	if !ok {
+		return false
+	}
+	if time.Now().After(until) {
+		delete(b.hosts, host)
+		return false
+	}
+	return true
+}
+
+// orderHosts returns the hosts in the order connection attempts should be made.
+// Blacklisted hosts are moved to the end of the list to be used as last resort.
+func orderHosts(hosts []string, loadBalancing LoadBalancing, counter *atomic.Uint64, blacklist *hostBlacklist) []string {
+	if loadBalancing == LbRoundRobin {
+		offset := int((counter.Add(1) - 1) % uint64(len(hosts))) //nolint:gosec
+		hosts = append(hosts[offset:], hosts[:offset]...)
+	}
+	available := make([]string, 0, len(hosts))
+	blacklisted := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		if blacklist.contains(host) {
+			blacklisted = append(blacklisted, host)
+		} else {
+			available = append(available, host)
+		}
+	}
+	return append(available, blacklisted...)
+}
+
+/*
+connectHosts calls fn for the hosts of the connector until fn succeeds.
+
+Hosts fn fails for are added to the host blacklist of the connector for the blacklist period. Authentication errors and
+context errors are returned immediately as trying another host would not help.
+*/
+func connectHosts[T any](ctx context.Context, c *Connector, fn func(host string) (T, error)) (T, error) {
+	hosts := splitHosts(c._host)
+	if len(hosts) == 1 {
+		return fn(hosts[0])
+	}
+
+	c.mu.RLock()
+	loadBalancing, blacklistPeriod, logger := c._loadBalancing, c._blacklistPeriod, c._logger
+	c.mu.RUnlock()
+
+	var zero T
+	errs := make([]error, 0, len(hosts))
+	for _, host := range orderHosts(hosts, loadBalancing, &c.rrCounter, &c.hostBlacklist) {
+		v, err := fn(host)
+		if err == nil {
+			c.hostBlacklist.remove(host)
+			return v, nil
+		}
+		if isAuthError(err) || ctx.Err() != nil {
+			return zero, err
+		}
+		logger.LogAttrs(ctx, slog.LevelWarn, "cannot connect to host - trying next host", slog.String("host", host), slog.Any("error", err))
+		if blacklistPeriod > 0 {
+			c.hostBlacklist.add(host, blacklistPeriod)
+		}
+		errs = append(errs, fmt.Errorf("host %s: %w", host, err))
+	}
+	return zero, errors.Join(errs...)
+}
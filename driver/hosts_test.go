@@ -0,0 +1,81 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestOrderHosts(t *testing.T) {
+	hosts := []string{"host1:30015", "host2:30015", "host3:30015"}
+
+	blacklist := &hostBlacklist{}
+	var counter atomic.Uint64
+
+	// failover: hosts in given order
+	for range 2 {
+		if ordered := orderHosts(slices.Clone(hosts), LbFailover, &counter, blacklist); !slices.Equal(ordered, hosts) {
+			t.Fatalf("hosts %v - expected %v", ordered, hosts)
+		}
+	}
+
+	// round-robin: first host rotates
+	for i := range 4 {
+		ordered := orderHosts(slices.Clone(hosts), LbRoundRobin, &counter, blacklist)
+		if expected := hosts[i%len(hosts)]; ordered[0] != expected {
+			t.Fatalf("first host %s - expected %s", ordered[0], expected)
+		}
+	}
+
+	// blacklisted hosts are moved to the end
+	blacklist.add("host1:30015", time.Minute)
+	expected := []string{"host2:30015", "host3:30015", "host1:30015"}
+	if ordered := orderHosts(slices.Clone(hosts), LbFailover, &counter, blacklist); !slices.Equal(ordered, expected) {
+		t.Fatalf("hosts %v - expected %v", ordered, expected)
+	}
+
+	// expired blacklist entries are removed
+	blacklist.add("host1:30015", -time.Minute)
+	if blacklist.contains("host1:30015") {
+		t.Fatal("host blacklisted - expected blacklist entry to be expired")
+	}
+}
+
+func TestConnectHosts(t *testing.T) {
+	ctx := context.Background()
+	errConnect := errors.New("connect error")
+
+	c := NewConnector()
+	c._host = "connectHost1:30015,connectHost2:30015"
+
+	var tried []string
+	host, err := connectHosts(ctx, c, func(host string) (string, error) {
+		tried = append(tried, host)
+		if host == "connectHost1:30015" {
+			return "", errConnect
+		}
+		return host, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if host != "connectHost2:30015" {
+		t.Fatalf("host %s - expected %s", host, "connectHost2:30015")
+	}
+	if !c.hostBlacklist.contains("connectHost1:30015") {
+		t.Fatal("host not blacklisted")
+	}
+
+	// all hosts failing
+	if _, err := connectHosts(ctx, c, func(host string) (string, error) { return "", errConnect }); !errors.Is(err, errConnect) {
+		t.Fatalf("error %v - expected %v", err, errConnect)
+	}
+
+	// blacklist is not shared between connectors
+	if NewConnector().hostBlacklist.contains("connectHost1:30015") {
+		t.Fatal("host blacklisted in other connector")
+	}
+}
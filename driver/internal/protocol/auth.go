@@ -1,7 +1,9 @@
 package protocol
 
 import (
+	"errors"
 	"fmt"
+	"maps"
 
 	"github.com/SAP/go-hdb/driver/internal/protocol/auth"
 	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
@@ -27,14 +29,18 @@ func (a *AuthHnd) AddSessionCookie(cookie []byte, logonname, clientID string) {
 }
 
 // AddBasic adds basic authentication methods.
-func (a *AuthHnd) AddBasic(username, password string) {
-	a.methods[auth.MtSCRAMPBKDF2SHA256] = auth.NewSCRAMPBKDF2SHA256(username, password)
+// The number of PBKDF2 rounds requested by the database server is checked against minRounds and maxRounds (0: no limit).
+func (a *AuthHnd) AddBasic(username, password string, minRounds, maxRounds uint32) {
+	a.methods[auth.MtSCRAMPBKDF2SHA256] = auth.NewSCRAMPBKDF2SHA256(username, password, minRounds, maxRounds)
 	a.methods[auth.MtSCRAMSHA256] = auth.NewSCRAMSHA256(username, password)
 }
 
 // AddJWT adds JWT authentication method.
 func (a *AuthHnd) AddJWT(token string) { a.methods[auth.MtJWT] = auth.NewJWT(token) }
 
+// AddSAML adds SAML authentication method.
+func (a *AuthHnd) AddSAML(assertion string) { a.methods[auth.MtSAML] = auth.NewSAML(assertion) }
+
 // AddX509 adds X509 authentication method.
 func (a *AuthHnd) AddX509(certKey *auth.CertKey) { a.methods[auth.MtX509] = auth.NewX509(certKey) }
 
@@ -43,6 +49,14 @@ func (a *AuthHnd) AddLDAP(username, password string) {
 	a.methods[auth.MtLDAP] = auth.NewLDAP(username, password)
 }
 
+// AddMethod adds an authentication method. A method with the same type replaces the existing one.
+func (a *AuthHnd) AddMethod(method auth.Method) { a.methods[method.Typ()] = method }
+
+// Restrict removes all authentication methods for which allowed returns false.
+func (a *AuthHnd) Restrict(allowed func(mt string) bool) {
+	maps.DeleteFunc(a.methods, func(mt string, _ auth.Method) bool { return !allowed(mt) })
+}
+
 // Selected returns the selected authentication method.
 func (a *AuthHnd) Selected() auth.Method { return a.selected }
 
@@ -56,6 +70,9 @@ func (a *AuthHnd) setMethod(mt string) error {
 
 // InitRequest returns the init request part.
 func (a *AuthHnd) InitRequest() (*AuthInitRequest, error) {
+	if len(a.methods) == 0 {
+		return nil, errors.New("no authentication method available")
+	}
 	prms := &auth.Prms{}
 	prms.AddCESU8String(a.logonname)
 	for _, m := range a.methods.Order() {
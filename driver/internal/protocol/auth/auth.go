@@ -14,13 +14,15 @@ import (
 authentication method types supported by the driver:
   - basic authentication (username, password based) (whether SCRAMSHA256 or SCRAMPBKDF2SHA256) and
   - X509 (client certificate) authentication and
-  - JWT (token) authentication
+  - JWT (token) authentication and
+  - SAML (bearer assertion) authentication
 */
 const (
 	MtSCRAMSHA256       = "SCRAMSHA256"       // password
 	MtSCRAMPBKDF2SHA256 = "SCRAMPBKDF2SHA256" // password pbkdf2
 	MtX509              = "X509"              // client certificate
 	MtJWT               = "JWT"               // json web token
+	MtSAML              = "SAML"              // SAML bearer assertion
 	MtSessionCookie     = "SessionCookie"     // session cookie
 	MtLDAP              = "LDAP"              // LDAP authentication
 )
@@ -30,6 +32,7 @@ const (
 	MoSessionCookie byte = iota
 	MoX509
 	MoJWT
+	MoSAML
 	MoSCRAMPBKDF2SHA256
 	MoSCRAMSHA256
 	MoLDAP
@@ -55,7 +58,10 @@ func (m Methods) Order() []Method {
 	for _, e := range m {
 		methods = append(methods, e)
 	}
-	slices.SortFunc(methods, func(m1, m2 Method) int { return cmp.Compare(m1.Order(), m2.Order()) })
+	// methods with equal order (e.g. custom methods) are ordered by type to get a deterministic order.
+	slices.SortStableFunc(methods, func(m1, m2 Method) int {
+		return cmp.Or(cmp.Compare(m1.Order(), m2.Order()), cmp.Compare(m1.Typ(), m2.Typ()))
+	})
 	return methods
 }
 
@@ -68,6 +74,7 @@ var (
 	_ Method = (*SCRAMSHA256)(nil)
 	_ Method = (*SCRAMPBKDF2SHA256)(nil)
 	_ Method = (*JWT)(nil)
+	_ Method = (*SAML)(nil)
 	_ Method = (*X509)(nil)
 	_ Method = (*SessionCookie)(nil)
 	_ Method = (*LDAP)(nil)
@@ -82,10 +89,18 @@ func (p *Prms) String() string { return fmt.Sprintf("%v", p.prms) }
 
 // AddCESU8String adds a CESU8 string parameter.
 func (p *Prms) AddCESU8String(s string) { p.prms = append(p.prms, s) } // unicode string
-func (p *Prms) addEmpty()               { p.prms = append(p.prms, []byte{}) }
-func (p *Prms) addBytes(b []byte)       { p.prms = append(p.prms, b) }
-func (p *Prms) addString(s string)      { p.prms = append(p.prms, []byte(s)) } // treat like bytes to distinguish from unicode string
-func (p *Prms) addPrms() *Prms {
+
+// AddEmpty adds an empty parameter.
+func (p *Prms) AddEmpty() { p.prms = append(p.prms, []byte{}) }
+
+// AddBytes adds a bytes parameter.
+func (p *Prms) AddBytes(b []byte) { p.prms = append(p.prms, b) }
+
+// AddString adds a string parameter.
+func (p *Prms) AddString(s string) { p.prms = append(p.prms, []byte(s)) } // treat like bytes to distinguish from unicode string
+
+// AddPrms adds a nested parameter list and returns it.
+func (p *Prms) AddPrms() *Prms {
 	prms := &Prms{}
 	p.prms = append(p.prms, prms)
 	return prms
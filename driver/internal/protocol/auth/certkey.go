@@ -40,26 +40,78 @@ type CertKey struct {
 	certBlocks            []*pem.Block
 	certs                 []*x509.Certificate
 	keyBlock              *pem.Block
+	keySigner             crypto.Signer // external signer (e.g. HSM or KMS held key) - keyBlock is nil
 }
 
-// NewCertKey returns a new certificate and key instance.
-func NewCertKey(certHandle, keyHandle unique.Handle[string]) (*CertKey, error) {
-	certBlocks, err := decodeClientCert([]byte(certHandle.Value()))
-	if err != nil {
-		return nil, err
+/*
+NewCertKey returns a new certificate and key instance.
+
+The certificate and key are expected either
+  - in PEM format, where the key might be a password encrypted PKCS#8 key or
+  - as DER encoded PKCS#12 bundle in certHandle containing certificate and key, where keyHandle is empty.
+
+The password is used to decrypt encrypted PKCS#8 keys and PKCS#12 bundles only.
+*/
+func NewCertKey(certHandle, keyHandle unique.Handle[string], password string) (*CertKey, error) {
+	var certBlocks []*pem.Block
+	var keyBlock *pem.Block
+	var err error
+	if isPKCS12(certHandle.Value()) {
+		if certBlocks, keyBlock, err = decodePKCS12([]byte(certHandle.Value()), password); err != nil {
+			return nil, err
+		}
+	} else {
+		if certBlocks, err = decodeClientCert([]byte(certHandle.Value())); err != nil {
+			return nil, err
+		}
+		if keyBlock, err = decodeClientKey([]byte(keyHandle.Value()), password); err != nil {
+			return nil, err
+		}
 	}
 	certs, err := parseCerts(certBlocks)
 	if err != nil {
 		return nil, err
 	}
-	keyBlock, err := decodeClientKey([]byte(keyHandle.Value()))
-	if err != nil {
-		return nil, err
-	}
 	return &CertKey{certHandle: certHandle, keyHandle: keyHandle, certBlocks: certBlocks, certs: certs, keyBlock: keyBlock}, nil
 }
 
+/*
+NewCertSigner returns a new certificate and key instance where the key is held by an external signer.
+
+The first certificate of certChain needs to be the client certificate belonging to the public key of the signer.
+Supported are RSA, ECDSA and Ed25519 keys.
+*/
+func NewCertSigner(certChain []*x509.Certificate, signer crypto.Signer) (*CertKey, error) {
+	if len(certChain) == 0 {
+		return nil, errors.New("invalid client certificate chain: empty")
+	}
+	if signer == nil {
+		return nil, errors.New("invalid signer: nil")
+	}
+	publicKey, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !publicKey.Equal(certChain[0].PublicKey) {
+		return nil, errors.New("public key of signer does not match the client certificate")
+	}
+	certBlocks := make([]*pem.Block, len(certChain))
+	var b bytes.Buffer
+	for i, cert := range certChain {
+		certBlocks[i] = &pem.Block{Type: pemTypeCertificate, Bytes: cert.Raw}
+		if err := pem.Encode(&b, certBlocks[i]); err != nil {
+			return nil, err
+		}
+	}
+	return &CertKey{certHandle: unique.Make(b.String()), certBlocks: certBlocks, certs: certChain, keySigner: signer}, nil
+}
+
+// isPKCS12 returns true if data is not PEM encoded, but a DER encoded ASN.1 sequence.
+func isPKCS12(data string) bool {
+	return len(data) > 0 && data[0] == 0x30 && !strings.Contains(data, "-----BEGIN")
+}
+
 func (ck *CertKey) String() string {
+	if ck.keySigner != nil {
+		return fmt.Sprintf("cert %s key signer %T", ck.certHandle.Value(), ck.keySigner)
+	}
 	return fmt.Sprintf("cert %s key %s", ck.certHandle.Value(), ck.keyHandle.Value())
 }
 
@@ -71,8 +123,13 @@ func (ck *CertKey) Equal(certHandle, keyHandle unique.Handle[string]) bool {
 // Cert returns the certificate.
 func (ck *CertKey) Cert() []byte { return []byte(ck.certHandle.Value()) }
 
-// Key returns the key.
-func (ck *CertKey) Key() []byte { return []byte(ck.keyHandle.Value()) }
+// Key returns the key (nil in case the key is held by an external signer).
+func (ck *CertKey) Key() []byte {
+	if ck.keySigner != nil {
+		return nil
+	}
+	return []byte(ck.keyHandle.Value())
+}
 
 // validate validates the certificate (currently validity period only).
 func (ck *CertKey) validate(t time.Time) error { return validateCerts(ck.certs, t) }
@@ -92,10 +149,13 @@ func validateCerts(certs []*x509.Certificate, t time.Time) error {
 
 // signer returns the cryptographic signer of the key.
 func (ck *CertKey) signer() (crypto.Signer, error) {
+	if ck.keySigner != nil {
+		return ck.keySigner, nil
+	}
 	switch ck.keyBlock.Type {
 	case "RSA PRIVATE KEY":
 		return x509.ParsePKCS1PrivateKey(ck.keyBlock.Bytes)
-	case "PRIVATE KEY":
+	case pemTypePrivateKey:
 		key, err := x509.ParsePKCS8PrivateKey(ck.keyBlock.Bytes)
 		if err != nil {
 			return nil, err
@@ -199,7 +259,7 @@ func encryptedBlock(block *pem.Block) bool {
 	return strings.Contains(block.Headers["Proc-Type"], "ENCRYPTED")
 }
 
-func decodeClientKey(data []byte) (*pem.Block, error) {
+func decodeClientKey(data []byte, password string) (*pem.Block, error) {
 	blocks := decodePEM(data)
 	switch {
 	case blocks == nil:
@@ -209,7 +269,14 @@ func decodeClientKey(data []byte) (*pem.Block, error) {
 	}
 	block := blocks[0]
 	if encryptedBlock(block) {
-		return nil, errors.New("client key is password encrypted")
+		return nil, errors.New("client key is encrypted with legacy PEM encryption - please use an encrypted PKCS#8 key")
+	}
+	if block.Type == pemTypeEncryptedPrivateKey {
+		key, err := decryptPKCS8(block.Bytes, password)
+		if err != nil {
+			return nil, err
+		}
+		return &pem.Block{Type: pemTypePrivateKey, Bytes: key}, nil
 	}
 	return block, nil
 }
@@ -29,8 +29,8 @@ func (a *JWT) Order() byte { return MoJWT }
 
 // PrepareInitReq implements the Method interface.
 func (a *JWT) PrepareInitReq(prms *Prms) error {
-	prms.addString(a.Typ())
-	prms.addString(a.token)
+	prms.AddString(a.Typ())
+	prms.AddString(a.token)
 	return nil
 }
 
@@ -43,8 +43,8 @@ func (a *JWT) InitRepDecode(d *encoding.Decoder) error {
 // PrepareFinalReq implements the Method interface.
 func (a *JWT) PrepareFinalReq(prms *Prms) error {
 	prms.AddCESU8String(a.logonname)
-	prms.addString(a.Typ())
-	prms.addEmpty() // empty parameter
+	prms.AddString(a.Typ())
+	prms.AddEmpty() // empty parameter
 	return nil
 }
 
@@ -58,15 +58,15 @@ func (a *LDAP) PrepareInitReq(prms *Prms) error {
 	a.clientChallenge = make([]byte, ldapClientChallengeSize)
 	rand.Read(a.clientChallenge) //nolint: errcheck // never returns error
 
-	prms.addString(a.Typ())
+	prms.AddString(a.Typ())
 
 	// Add sub-parameters: client challenge and capabilities
-	subPrms := prms.addPrms()
-	subPrms.addBytes(a.clientChallenge)
+	subPrms := prms.AddPrms()
+	subPrms.AddBytes(a.clientChallenge)
 
 	capabilities := make([]byte, ldapCapabilitiesSize)
 	capabilities[0] = ldapDefaultCapabilities
-	subPrms.addBytes(capabilities)
+	subPrms.AddBytes(capabilities)
 
 	return nil
 }
@@ -130,11 +130,11 @@ func (a *LDAP) PrepareFinalReq(prms *Prms) error {
 	}
 
 	prms.AddCESU8String(a.username)
-	prms.addString(a.Typ())
+	prms.AddString(a.Typ())
 
-	subPrms := prms.addPrms()
-	subPrms.addBytes(encryptedSessionKey)
-	subPrms.addBytes(encryptedPassword)
+	subPrms := prms.AddPrms()
+	subPrms.AddBytes(encryptedSessionKey)
+	subPrms.AddBytes(encryptedPassword)
 
 	return nil
 }
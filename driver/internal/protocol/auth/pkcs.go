@@ -0,0 +1,507 @@
+package auth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des" //nolint:gosec // needed for legacy PKCS#12 bundles
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/sha1" //nolint:gosec // needed for legacy PKCS#12 bundles
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"unicode/utf16"
+)
+
+// ErrInvalidKeyPassword is returned if an encrypted client key or a PKCS#12 bundle cannot be decrypted with the provided password.
+var ErrInvalidKeyPassword = errors.New("invalid client key password")
+
+// maxIterations limits the iteration count of key derivations read from encrypted keys and PKCS#12 bundles,
+// as the iteration count is part of the (untrusted) file content.
+const maxIterations = 1 << 20
+
+func checkIterations(iterations int) error {
+	if iterations < 1 || iterations > maxIterations {
+		return fmt.Errorf("invalid key derivation iteration count %d - expected 1 - %d", iterations, maxIterations)
+	}
+	return nil
+}
+
+// pem block types.
+const (
+	pemTypeCertificate         = "CERTIFICATE"
+	pemTypePrivateKey          = "PRIVATE KEY"
+	pemTypeEncryptedPrivateKey = "ENCRYPTED PRIVATE KEY"
+)
+
+var (
+	// PKCS#5.
+	oidPBES2  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	// pseudo random functions.
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA224 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 8}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidHMACWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 10}
+	oidHMACWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 11}
+	// encryption schemes.
+	oidAES128CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	oidDESEDE3CBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 7}
+	// digests.
+	oidSHA1   = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+	// PKCS#12 password based encryption.
+	oidPBEWithSHAAnd3KeyTripleDESCBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 3}
+	// PKCS#7 content types.
+	oidData          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidEncryptedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 6}
+	// PKCS#12 bag types.
+	oidKeyBag              = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 1}
+	oidPKCS8ShroudedKeyBag = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidCertBag             = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidX509Certificate     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+)
+
+// encryptedPrivateKeyInfo (RFC 5208).
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+// pbes2Params (RFC 8018).
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+// pbkdf2Params (RFC 8018).
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// pbeParams (RFC 7292).
+type pbeParams struct {
+	Salt       []byte
+	Iterations int
+}
+
+// pfxPDU (RFC 7292).
+type pfxPDU struct {
+	Version  int
+	AuthSafe contentInfo
+	MacData  macData `asn1:"optional"`
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"tag:0,explicit,optional"`
+}
+
+type encryptedData struct {
+	Version              int
+	EncryptedContentInfo encryptedContentInfo
+}
+
+type encryptedContentInfo struct {
+	ContentType                asn1.ObjectIdentifier
+	ContentEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedContent           []byte `asn1:"tag:0,optional"`
+}
+
+type macData struct {
+	Mac        digestInfo
+	MacSalt    []byte
+	Iterations int `asn1:"optional,default:1"`
+}
+
+type digestInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	Digest    []byte
+}
+
+type safeBag struct {
+	ID         asn1.ObjectIdentifier
+	Value      asn1.RawValue `asn1:"tag:0,explicit"`
+	Attributes asn1.RawValue `asn1:"optional"`
+}
+
+type certBag struct {
+	ID   asn1.ObjectIdentifier
+	Data []byte `asn1:"tag:0,explicit"`
+}
+
+func unsupportedAlgorithmError(oid asn1.ObjectIdentifier) error {
+	return fmt.Errorf("unsupported algorithm %s", oid)
+}
+
+func prfHash(oid asn1.ObjectIdentifier) (func() hash.Hash, error) {
+	switch {
+	case oid == nil, oid.Equal(oidHMACWithSHA1): // default
+		return sha1.New, nil
+	case oid.Equal(oidHMACWithSHA224):
+		return sha256.New224, nil
+	case oid.Equal(oidHMACWithSHA256):
+		return sha256.New, nil
+	case oid.Equal(oidHMACWithSHA384):
+		return sha512.New384, nil
+	case oid.Equal(oidHMACWithSHA512):
+		return sha512.New, nil
+	default:
+		return nil, unsupportedAlgorithmError(oid)
+	}
+}
+
+func blockCipher(oid asn1.ObjectIdentifier) (func(key []byte) (cipher.Block, error), int, error) {
+	switch {
+	case oid.Equal(oidAES128CBC):
+		return aes.NewCipher, 16, nil
+	case oid.Equal(oidAES192CBC):
+		return aes.NewCipher, 24, nil
+	case oid.Equal(oidAES256CBC):
+		return aes.NewCipher, 32, nil
+	case oid.Equal(oidDESEDE3CBC):
+		return des.NewTripleDESCipher, 24, nil
+	default:
+		return nil, 0, unsupportedAlgorithmError(oid)
+	}
+}
+
+// decryptCBC decrypts data in CBC mode and removes the PKCS#7 padding.
+func decryptCBC(block cipher.Block, iv, data []byte) ([]byte, error) {
+	blockSize := block.BlockSize()
+	if len(iv) != blockSize {
+		return nil, fmt.Errorf("invalid initialization vector size %d - expected %d", len(iv), blockSize)
+	}
+	if len(data) == 0 || len(data)%blockSize != 0 {
+		return nil, ErrInvalidKeyPassword
+	}
+	b := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(b, data)
+
+	// a wrong password results most likely in an invalid padding.
+	n := int(b[len(b)-1])
+	if n == 0 || n > blockSize {
+		return nil, ErrInvalidKeyPassword
+	}
+	for _, c := range b[len(b)-n:] {
+		if int(c) != n {
+			return nil, ErrInvalidKeyPassword
+		}
+	}
+	return b[:len(b)-n], nil
+}
+
+func decryptPBES2(params []byte, data []byte, password string) ([]byte, error) {
+	var pbes2 pbes2Params
+	if _, err := asn1.Unmarshal(params, &pbes2); err != nil {
+		return nil, err
+	}
+	if !pbes2.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, unsupportedAlgorithmError(pbes2.KeyDerivationFunc.Algorithm)
+	}
+	var kdf pbkdf2Params
+	if _, err := asn1.Unmarshal(pbes2.KeyDerivationFunc.Parameters.FullBytes, &kdf); err != nil {
+		return nil, err
+	}
+	h, err := prfHash(kdf.PRF.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	newCipher, keyLength, err := blockCipher(pbes2.EncryptionScheme.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(pbes2.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+		return nil, err
+	}
+	if err := checkIterations(kdf.IterationCount); err != nil {
+		return nil, err
+	}
+	key, err := pbkdf2.Key(h, password, kdf.Salt, kdf.IterationCount, keyLength)
+	if err != nil {
+		return nil, err
+	}
+	block, err := newCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return decryptCBC(block, iv, data)
+}
+
+// bmpPassword returns the password as null terminated BMPString (RFC 7292 Appendix B.1).
+func bmpPassword(password string) []byte {
+	u := utf16.Encode([]rune(password))
+	b := make([]byte, 0, 2*len(u)+2)
+	for _, r := range u {
+		b = append(b, byte(r>>8), byte(r))
+	}
+	return append(b, 0, 0)
+}
+
+// pkcs12KDF implements the PKCS#12 key derivation function (RFC 7292 Appendix B.2).
+func pkcs12KDF(h func() hash.Hash, v int, password, salt []byte, iterations int, id byte, size int) []byte {
+	fill := func(b []byte) []byte {
+		if len(b) == 0 {
+			return nil
+		}
+		rv := make([]byte, v*((len(b)+v-1)/v))
+		for i := range rv {
+			rv[i] = b[i%len(b)]
+		}
+		return rv
+	}
+
+	d := bytes.Repeat([]byte{id}, v)
+	i := append(fill(salt), fill(password)...)
+
+	var rv []byte
+	for len(rv) < size {
+		hh := h()
+		hh.Write(d)
+		hh.Write(i)
+		a := hh.Sum(nil)
+		for range iterations - 1 {
+			hh.Reset()
+			hh.Write(a)
+			a = hh.Sum(a[:0])
+		}
+		rv = append(rv, a...)
+
+		b := fill(a)[:v]
+		for j := 0; j < len(i); j += v {
+			// i[j:j+v] = (i[j:j+v] + b + 1) mod 2^(8*v)
+			carry := 1
+			for k := v - 1; k >= 0; k-- {
+				x := int(i[j+k]) + int(b[k]) + carry
+				i[j+k] = byte(x)
+				carry = x >> 8
+			}
+		}
+	}
+	return rv[:size]
+}
+
+func decryptPBEWithSHAAnd3KeyTripleDESCBC(params []byte, data []byte, password string) ([]byte, error) {
+	var pbe pbeParams
+	if _, err := asn1.Unmarshal(params, &pbe); err != nil {
+		return nil, err
+	}
+	if err := checkIterations(pbe.Iterations); err != nil {
+		return nil, err
+	}
+	p := bmpPassword(password)
+	key := pkcs12KDF(sha1.New, 64, p, pbe.Salt, pbe.Iterations, 1, 24)
+	iv := pkcs12KDF(sha1.New, 64, p, pbe.Salt, pbe.Iterations, 2, 8)
+	block, err := des.NewTripleDESCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return decryptCBC(block, iv, data)
+}
+
+// decryptPBE decrypts password based encrypted data.
+func decryptPBE(algorithm pkix.AlgorithmIdentifier, data []byte, password string) ([]byte, error) {
+	switch {
+	case algorithm.Algorithm.Equal(oidPBES2):
+		return decryptPBES2(algorithm.Parameters.FullBytes, data, password)
+	case algorithm.Algorithm.Equal(oidPBEWithSHAAnd3KeyTripleDESCBC):
+		return decryptPBEWithSHAAnd3KeyTripleDESCBC(algorithm.Parameters.FullBytes, data, password)
+	default:
+		return nil, unsupportedAlgorithmError(algorithm.Algorithm)
+	}
+}
+
+// decryptPKCS8 decrypts an encrypted PKCS#8 private key and returns the unencrypted PKCS#8 private key.
+func decryptPKCS8(der []byte, password string) ([]byte, error) {
+	var info encryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, err
+	}
+	b, err := decryptPBE(info.Algorithm, info.EncryptedData, password)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := x509.ParsePKCS8PrivateKey(b); err != nil {
+		return nil, ErrInvalidKeyPassword
+	}
+	return b, nil
+}
+
+func macHash(oid asn1.ObjectIdentifier) (func() hash.Hash, int, error) {
+	switch {
+	case oid.Equal(oidSHA1):
+		return sha1.New, 64, nil
+	case oid.Equal(oidSHA256):
+		return sha256.New, 64, nil
+	case oid.Equal(oidSHA384):
+		return sha512.New384, 128, nil
+	case oid.Equal(oidSHA512):
+		return sha512.New, 128, nil
+	default:
+		return nil, 0, unsupportedAlgorithmError(oid)
+	}
+}
+
+func verifyPKCS12MAC(md *macData, content []byte, password string) error {
+	h, v, err := macHash(md.Mac.Algorithm.Algorithm)
+	if err != nil {
+		return err
+	}
+	if err := checkIterations(md.Iterations); err != nil {
+		return err
+	}
+	key := pkcs12KDF(h, v, bmpPassword(password), md.MacSalt, md.Iterations, 3, h().Size())
+	mac := hmac.New(h, key)
+	mac.Write(content)
+	if subtle.ConstantTimeCompare(mac.Sum(nil), md.Mac.Digest) != 1 {
+		return ErrInvalidKeyPassword
+	}
+	return nil
+}
+
+func unmarshalOctetString(b []byte) ([]byte, error) {
+	var rv []byte
+	if _, err := asn1.Unmarshal(b, &rv); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+/*
+decodePKCS12 decodes a DER encoded PKCS#12 bundle and returns the certificate and the private key blocks.
+
+Supported are bundles using PBES2 (PBKDF2 with AES or 3DES) and the PKCS#12 3DES password based encryption.
+The certificate belonging to the private key is returned as first certificate block.
+*/
+func decodePKCS12(data []byte, password string) ([]*pem.Block, *pem.Block, error) {
+	var pfx pfxPDU
+	if _, err := asn1.Unmarshal(data, &pfx); err != nil {
+		return nil, nil, fmt.Errorf("invalid PKCS#12 bundle: %w", err)
+	}
+	if pfx.Version != 3 {
+		return nil, nil, fmt.Errorf("invalid PKCS#12 version %d - expected 3", pfx.Version)
+	}
+	if !pfx.AuthSafe.ContentType.Equal(oidData) {
+		return nil, nil, fmt.Errorf("unsupported PKCS#12 content type %s", pfx.AuthSafe.ContentType)
+	}
+	content, err := unmarshalOctetString(pfx.AuthSafe.Content.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pfx.MacData.Mac.Algorithm.Algorithm != nil {
+		if err := verifyPKCS12MAC(&pfx.MacData, content, password); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var authenticatedSafe []contentInfo
+	if _, err := asn1.Unmarshal(content, &authenticatedSafe); err != nil {
+		return nil, nil, err
+	}
+
+	var certs [][]byte
+	var key []byte
+	for _, ci := range authenticatedSafe {
+		var safeContents []byte
+		switch {
+		case ci.ContentType.Equal(oidData):
+			if safeContents, err = unmarshalOctetString(ci.Content.Bytes); err != nil {
+				return nil, nil, err
+			}
+		case ci.ContentType.Equal(oidEncryptedData):
+			var ed encryptedData
+			if _, err := asn1.Unmarshal(ci.Content.Bytes, &ed); err != nil {
+				return nil, nil, err
+			}
+			eci := ed.EncryptedContentInfo
+			if safeContents, err = decryptPBE(eci.ContentEncryptionAlgorithm, eci.EncryptedContent, password); err != nil {
+				return nil, nil, err
+			}
+		default:
+			return nil, nil, fmt.Errorf("unsupported PKCS#12 content type %s", ci.ContentType)
+		}
+
+		var bags []safeBag
+		if _, err := asn1.Unmarshal(safeContents, &bags); err != nil {
+			return nil, nil, err
+		}
+		for _, bag := range bags {
+			switch {
+			case bag.ID.Equal(oidCertBag):
+				var cb certBag
+				if _, err := asn1.Unmarshal(bag.Value.Bytes, &cb); err != nil {
+					return nil, nil, err
+				}
+				if !cb.ID.Equal(oidX509Certificate) {
+					return nil, nil, fmt.Errorf("unsupported PKCS#12 certificate type %s", cb.ID)
+				}
+				certs = append(certs, cb.Data)
+			case bag.ID.Equal(oidKeyBag):
+				key = bag.Value.Bytes
+			case bag.ID.Equal(oidPKCS8ShroudedKeyBag):
+				if key, err = decryptPKCS8(bag.Value.Bytes, password); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+	}
+	if len(certs) == 0 {
+		return nil, nil, errors.New("no certificate found in PKCS#12 bundle")
+	}
+	if key == nil {
+		return nil, nil, errors.New("no private key found in PKCS#12 bundle")
+	}
+
+	certs, err = orderCerts(certs, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	certBlocks := make([]*pem.Block, len(certs))
+	for i, cert := range certs {
+		certBlocks[i] = &pem.Block{Type: pemTypeCertificate, Bytes: cert}
+	}
+	return certBlocks, &pem.Block{Type: pemTypePrivateKey, Bytes: key}, nil
+}
+
+// orderCerts moves the certificate belonging to the private key to the first position.
+func orderCerts(certs [][]byte, key []byte) ([][]byte, error) {
+	privateKey, err := x509.ParsePKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("internal error: parsed PKCS8 private key is not a crypto.Signer")
+	}
+	publicKey, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok {
+		return nil, errors.New("internal error: public key does not implement Equal")
+	}
+	for i, b := range certs {
+		cert, err := x509.ParseCertificate(b)
+		if err != nil {
+			return nil, err
+		}
+		if publicKey.Equal(cert.PublicKey) {
+			return append([][]byte{b}, append(certs[:i:i], certs[i+1:]...)...), nil
+		}
+	}
+	return nil, errors.New("no certificate found for private key in PKCS#12 bundle")
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
+)
+
+// SAML implements SAML (bearer assertion) authentication.
+type SAML struct {
+	assertion string
+	logonname string
+	_cookie   []byte
+}
+
+// NewSAML creates a new authSAML instance.
+func NewSAML(assertion string) *SAML { return &SAML{assertion: assertion} }
+
+func (a *SAML) String() string {
+	return fmt.Sprintf("method type %s assertion size %d", a.Typ(), len(a.assertion))
+}
+
+// Cookie implements the AuthCookieGetter interface.
+func (a *SAML) Cookie() (string, []byte) { return a.logonname, a._cookie }
+
+// Typ implements the Method interface.
+func (a *SAML) Typ() string { return MtSAML }
+
+// Order implements the Method interface.
+func (a *SAML) Order() byte { return MoSAML }
+
+// PrepareInitReq implements the Method interface.
+func (a *SAML) PrepareInitReq(prms *Prms) error {
+	prms.AddString(a.Typ())
+	prms.AddString(a.assertion)
+	return nil
+}
+
+// InitRepDecode implements the Method interface.
+func (a *SAML) InitRepDecode(d *encoding.Decoder) error {
+	a.logonname = d.AuthString()
+	return nil
+}
+
+// PrepareFinalReq implements the Method interface.
+func (a *SAML) PrepareFinalReq(prms *Prms) error {
+	prms.AddCESU8String(a.logonname)
+	prms.AddString(a.Typ())
+	prms.AddEmpty() // empty parameter
+	return nil
+}
+
+// FinalRepDecode implements the Method interface.
+func (a *SAML) FinalRepDecode(d *encoding.Decoder) error {
+	if err := DecodeAndCheckNumPrm(d, 2); err != nil {
+		return err
+	}
+	mt := d.AuthString()
+	if err := checkAuthMethodType(mt, a.Typ()); err != nil {
+		return err
+	}
+	a._cookie = d.AuthBytes()
+	return nil
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -60,3 +61,28 @@ func TestSCRAM(t *testing.T) {
 		}
 	}
 }
+
+func TestSCRAMPBKDF2Rounds(t *testing.T) {
+	testData := []struct {
+		rounds, minRounds, maxRounds uint32
+		valid                        bool
+	}{
+		{15000, 0, 0, true},
+		{15000, 15000, 0, true},
+		{15000, 0, 15000, true},
+		{1000, 10000, 0, false},
+		{1000000, 0, 100000, false},
+	}
+
+	for i, r := range testData {
+		a := NewSCRAMPBKDF2SHA256("user", "password", r.minRounds, r.maxRounds)
+		a.rounds = r.rounds
+		err := a.checkRounds()
+		if r.valid && err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !r.valid && !errors.Is(err, ErrPBKDF2Rounds) {
+			t.Fatalf("%d: error %v - expected %v", i, err, ErrPBKDF2Rounds)
+		}
+	}
+}
@@ -6,11 +6,15 @@ import (
 	"bytes"
 	"crypto/pbkdf2"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 
 	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
 )
 
+// ErrPBKDF2Rounds is returned if the number of PBKDF2 rounds requested by the database server is outside the client limits.
+var ErrPBKDF2Rounds = errors.New("PBKDF2 rounds outside of client limits")
+
 func scrampbkdf2sha256Key(password string, salt []byte, rounds int) ([]byte, error) {
 	b, err := pbkdf2.Key(sha256.New, password, salt, rounds, scramClientProofSize)
 	if err != nil {
@@ -31,11 +35,23 @@ type SCRAMPBKDF2SHA256 struct {
 	salt, serverChallenge []byte
 	serverProof           []byte
 	rounds                uint32
+	minRounds, maxRounds  uint32 // client limits (0: no limit)
 }
 
 // NewSCRAMPBKDF2SHA256 creates a new authSCRAMPBKDF2SHA256 instance.
-func NewSCRAMPBKDF2SHA256(username, password string) *SCRAMPBKDF2SHA256 {
-	return &SCRAMPBKDF2SHA256{username: username, password: password, clientChallenge: scramClientChallenge()}
+// The number of PBKDF2 rounds requested by the database server is checked against minRounds and maxRounds (0: no limit).
+func NewSCRAMPBKDF2SHA256(username, password string, minRounds, maxRounds uint32) *SCRAMPBKDF2SHA256 {
+	return &SCRAMPBKDF2SHA256{username: username, password: password, clientChallenge: scramClientChallenge(), minRounds: minRounds, maxRounds: maxRounds}
+}
+
+func (a *SCRAMPBKDF2SHA256) checkRounds() error {
+	if a.minRounds != 0 && a.rounds < a.minRounds {
+		return fmt.Errorf("%w: server rounds %d below minimum %d", ErrPBKDF2Rounds, a.rounds, a.minRounds)
+	}
+	if a.maxRounds != 0 && a.rounds > a.maxRounds {
+		return fmt.Errorf("%w: server rounds %d above maximum %d", ErrPBKDF2Rounds, a.rounds, a.maxRounds)
+	}
+	return nil
 }
 
 func (a *SCRAMPBKDF2SHA256) String() string {
@@ -55,8 +71,8 @@ func (a *SCRAMPBKDF2SHA256) Order() byte { return MoSCRAMPBKDF2SHA256 }
 
 // PrepareInitReq implements the Method interface.
 func (a *SCRAMPBKDF2SHA256) PrepareInitReq(prms *Prms) error {
-	prms.addString(a.Typ())
-	prms.addBytes(a.clientChallenge)
+	prms.AddString(a.Typ())
+	prms.AddBytes(a.clientChallenge)
 	return nil
 }
 
@@ -78,7 +94,7 @@ func (a *SCRAMPBKDF2SHA256) InitRepDecode(d *encoding.Decoder) error {
 	if a.rounds, err = d.AuthBigUint32(); err != nil {
 		return err
 	}
-	return nil
+	return a.checkRounds()
 }
 
 // PrepareFinalReq implements the Method interface.
@@ -93,9 +109,9 @@ func (a *SCRAMPBKDF2SHA256) PrepareFinalReq(prms *Prms) error {
 	}
 
 	prms.AddCESU8String(a.username)
-	prms.addString(a.Typ())
-	subPrms := prms.addPrms()
-	subPrms.addBytes(clientProof)
+	prms.AddString(a.Typ())
+	subPrms := prms.AddPrms()
+	subPrms.AddBytes(clientProof)
 
 	return nil
 }
@@ -48,8 +48,8 @@ func (a *SCRAMSHA256) Order() byte { return MoSCRAMSHA256 }
 
 // PrepareInitReq implements the Method interface.
 func (a *SCRAMSHA256) PrepareInitReq(prms *Prms) error {
-	prms.addString(a.Typ())
-	prms.addBytes(a.clientChallenge)
+	prms.AddString(a.Typ())
+	prms.AddBytes(a.clientChallenge)
 	return nil
 }
 
@@ -82,9 +82,9 @@ func (a *SCRAMSHA256) PrepareFinalReq(prms *Prms) error {
 	}
 
 	prms.AddCESU8String(a.username)
-	prms.addString(a.Typ())
-	subPrms := prms.addPrms()
-	subPrms.addBytes(clientProof)
+	prms.AddString(a.Typ())
+	subPrms := prms.AddPrms()
+	subPrms.AddBytes(clientProof)
 
 	return nil
 }
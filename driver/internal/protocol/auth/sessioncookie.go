@@ -30,8 +30,8 @@ func (a *SessionCookie) Order() byte { return MoSessionCookie }
 
 // PrepareInitReq implements the Method interface.
 func (a *SessionCookie) PrepareInitReq(prms *Prms) error {
-	prms.addString(a.Typ())
-	prms.addBytes(append(a.cookie, a.clientID...)) // cookie + clientID !!!
+	prms.AddString(a.Typ())
+	prms.AddBytes(append(a.cookie, a.clientID...)) // cookie + clientID !!!
 	return nil
 }
 
@@ -43,8 +43,8 @@ func (a *SessionCookie) InitRepDecode(d *encoding.Decoder) error {
 // PrepareFinalReq implements the Method interface.
 func (a *SessionCookie) PrepareFinalReq(prms *Prms) error {
 	prms.AddCESU8String(a.logonname)
-	prms.addString(a.Typ())
-	prms.addEmpty() // empty parameter
+	prms.AddString(a.Typ())
+	prms.AddEmpty() // empty parameter
 	return nil
 }
 
@@ -40,8 +40,8 @@ func (a *X509) PrepareInitReq(prms *Prms) error {
 	if err := a.certKey.validate(time.Now()); err != nil {
 		return err
 	}
-	prms.addString(a.Typ())
-	prms.addEmpty()
+	prms.AddString(a.Typ())
+	prms.AddEmpty()
 	return nil
 }
 
@@ -56,10 +56,10 @@ func (a *X509) InitRepDecode(d *encoding.Decoder) error {
 
 // PrepareFinalReq implements the Method interface.
 func (a *X509) PrepareFinalReq(prms *Prms) error {
-	prms.addEmpty() // empty username
-	prms.addString(a.Typ())
+	prms.AddEmpty() // empty username
+	prms.AddString(a.Typ())
 
-	subPrms := prms.addPrms()
+	subPrms := prms.AddPrms()
 
 	certBlocks := a.certKey.certBlocks
 
@@ -67,15 +67,15 @@ func (a *X509) PrepareFinalReq(prms *Prms) error {
 
 	message := bytes.NewBuffer(certBlocks[0].Bytes)
 
-	subPrms.addBytes(certBlocks[0].Bytes)
+	subPrms.AddBytes(certBlocks[0].Bytes)
 
 	if numBlocks == 1 {
-		subPrms.addEmpty()
+		subPrms.AddEmpty()
 	} else {
-		chainPrms := subPrms.addPrms()
+		chainPrms := subPrms.AddPrms()
 		for _, block := range certBlocks[1:] {
 			message.Write(block.Bytes)
-			chainPrms.addBytes(block.Bytes)
+			chainPrms.AddBytes(block.Bytes)
 		}
 	}
 
@@ -85,7 +85,7 @@ func (a *X509) PrepareFinalReq(prms *Prms) error {
 	if err != nil {
 		return err
 	}
-	subPrms.addBytes(signature)
+	subPrms.AddBytes(signature)
 	return nil
 }
 
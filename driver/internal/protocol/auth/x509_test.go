@@ -8,6 +8,11 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
 	"os"
 	"path/filepath"
 	"testing"
@@ -145,6 +150,95 @@ func testSignEd25519(t *testing.T) {
 	}
 }
 
+// opaqueSigner hides the private key type like HSM or KMS based signers.
+type opaqueSigner struct {
+	signer crypto.Signer
+}
+
+func (s *opaqueSigner) Public() crypto.PublicKey { return s.signer.Public() }
+func (s *opaqueSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.signer.Sign(rand, digest, opts)
+}
+
+func testCertSigner(t *testing.T, signer crypto.Signer) {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "go-hdb test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certKey, err := NewCertSigner([]*x509.Certificate{cert}, &opaqueSigner{signer: signer})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := certKey.validate(time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	message := []byte("test")
+	signature, err := certKey.sign(bytes.NewBuffer(message))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ok bool
+	switch publicKey := signer.Public().(type) {
+	case *rsa.PublicKey:
+		hashed := sha256.Sum256(message)
+		ok = rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, hashed[:], signature) == nil
+	case *ecdsa.PublicKey:
+		hashed, _ := ecdsaDigest(publicKey, bytes.NewBuffer(message))
+		ok = ecdsa.VerifyASN1(publicKey, hashed, signature)
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(publicKey, message, signature)
+	}
+	if !ok {
+		t.Fatalf("invalid signature for key type %T", signer.Public())
+	}
+
+	// signer not matching the certificate
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewCertSigner([]*x509.Certificate{cert}, otherKey); err == nil {
+		t.Fatal("expected error for signer not matching the certificate")
+	}
+}
+
+func testCertSignerRsa(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCertSigner(t, privKey)
+}
+
+func testCertSignerEcdsa(t *testing.T) {
+	privKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCertSigner(t, privKey)
+}
+
+func testCertSignerEd25519(t *testing.T) {
+	_, privKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCertSigner(t, privKey)
+}
+
 func TestX509(t *testing.T) {
 	tests := []struct {
 		name string
@@ -156,6 +250,9 @@ func TestX509(t *testing.T) {
 		{"testSignEcdsaP384", testSignEcdsaP384},
 		{"testSignEcdsaP521", testSignEcdsaP521},
 		{"testSignEd25519", testSignEd25519},
+		{"testCertSignerRsa", testCertSignerRsa},
+		{"testCertSignerEcdsa", testCertSignerEcdsa},
+		{"testCertSignerEd25519", testCertSignerEd25519},
 	}
 	t.Parallel()
 	for _, test := range tests {
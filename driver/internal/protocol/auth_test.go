@@ -100,12 +100,85 @@ func testJWTAuth(t *testing.T) {
 	}
 }
 
+func testSAMLAuth(t *testing.T) {
+	a := NewAuthHnd("")
+	a.AddSAML("<saml:Assertion/>")
+
+	successful := t.Run("init request", func(t *testing.T) {
+		initRequest, err := a.InitRequest()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual := authEncodeStep(t, initRequest)
+		expected := []byte("\x03\x00\x00\x04SAML\x11<saml:Assertion/>")
+
+		if !bytes.Equal(expected, actual) {
+			t.Fatalf("expected %q, got %q", string(expected), string(actual))
+		}
+	})
+
+	if successful {
+		successful = t.Run("init reply", func(t *testing.T) {
+			initReply, err := a.InitReply()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			authDecodeStep(t, initReply, []byte("\x02\x00\x04SAML\x07USER123"))
+
+			authSAML := a.Selected().(*auth.SAML)
+
+			logonname, _ := authSAML.Cookie()
+			if logonname != "USER123" {
+				t.Fatalf("expected USER123, got %s", logonname)
+			}
+		})
+	}
+
+	if successful {
+		successful = t.Run("final request", func(t *testing.T) {
+			finalRequest, err := a.FinalRequest()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual := authEncodeStep(t, finalRequest)
+			expected := []byte("\x03\x00\x07USER123\x04SAML\x00")
+
+			if !bytes.Equal(expected, actual) {
+				t.Fatalf("expected %q, got %q", string(expected), string(actual))
+			}
+		})
+	}
+
+	if successful {
+		t.Run("final reply", func(t *testing.T) {
+			finalReply, err := a.FinalReply()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			authDecodeStep(t, finalReply, []byte("\x02\x00\x04SAML\x205be8f43e064e0589ce07ba9de6fce107"))
+
+			const expectedCookie = "5be8f43e064e0589ce07ba9de6fce107"
+
+			authSAML := a.Selected().(*auth.SAML)
+			_, cookie := authSAML.Cookie()
+			if string(cookie) != expectedCookie {
+				t.Fatalf("expected %q, got %q", expectedCookie, string(cookie))
+			}
+		})
+	}
+}
+
 func TestAuth(t *testing.T) {
 	tests := []struct {
 		name string
 		fct  func(t *testing.T)
 	}{
 		{"testJWTAuth", testJWTAuth},
+		{"testSAMLAuth", testSAMLAuth},
 	}
 
 	for _, test := range tests {
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
 	"golang.org/x/text/transform"
 )
 
@@ -33,6 +34,10 @@ var (
 	bytesReflectType  = reflect.TypeFor[[]byte]()
 	stringReflectType = reflect.TypeFor[string]()
 	ratReflectType    = reflect.TypeFor[big.Rat]()
+
+	float32SliceReflectType = reflect.TypeFor[[]float32]()
+	intervalReflectType     = reflect.TypeFor[encoding.Interval]()
+	durationReflectType     = reflect.TypeFor[time.Duration]()
 )
 
 var (
@@ -40,6 +45,7 @@ var (
 	errUint64OutOfRange       = errors.New("uint64 values with high bit set are not supported")
 	errIntegerOutOfRange      = errors.New("integer out of range")
 	errFloatOutOfRange        = errors.New("float out of range")
+	errIntervalMismatch       = errors.New("year-month and day-second interval mismatch")
 )
 
 /*
@@ -437,6 +443,41 @@ func convertTime(v any) (any, error) {
 	}
 }
 
+func convertInterval(v any, yearMonth bool) (any, error) {
+	switch v := v.(type) {
+	case encoding.Interval:
+		if (yearMonth && v.Duration != 0) || (!yearMonth && v.Months != 0) {
+			return nil, errIntervalMismatch
+		}
+		if yearMonth && (v.Months < minInteger || v.Months > maxInteger) {
+			return nil, errIntegerOutOfRange
+		}
+		return v, nil
+	case time.Duration:
+		if yearMonth {
+			return nil, errIntervalMismatch
+		}
+		return encoding.Interval{Duration: v}, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return convertInterval(rv.Elem().Interface(), yearMonth)
+	default:
+		if rv.Type().ConvertibleTo(intervalReflectType) {
+			return convertInterval(rv.Convert(intervalReflectType).Interface(), yearMonth)
+		}
+		if rv.Type().ConvertibleTo(durationReflectType) {
+			return convertInterval(rv.Convert(durationReflectType).Interface(), yearMonth)
+		}
+		return nil, errConversionNotSupported
+	}
+}
+
 var (
 	ratZero = big.NewRat(0, 1)
 	ratOne  = big.NewRat(1, 1)
@@ -613,6 +654,43 @@ func convertLob(v any, cesu8Encoder transform.Transformer) (any, error) {
 	}
 }
 
+func convertRealVector(v any) (any, error) {
+	switch v := v.(type) {
+	case []float32:
+		return v, nil
+	case []byte: // binary vector format (dimension and values)
+		return v, nil
+	case []float64:
+		fv := make([]float32, len(v))
+		for i, f := range v {
+			if math.Abs(f) > maxReal {
+				return nil, errFloatOutOfRange
+			}
+			fv[i] = float32(f)
+		}
+		return fv, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice:
+		if rv.Type().ConvertibleTo(float32SliceReflectType) {
+			return rv.Convert(float32SliceReflectType).Interface(), nil
+		}
+		if rv.Type().ConvertibleTo(bytesReflectType) {
+			return rv.Convert(bytesReflectType).Interface(), nil
+		}
+		return nil, errConversionNotSupported
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return convertRealVector(rv.Elem().Interface())
+	default:
+		return nil, errConversionNotSupported
+	}
+}
+
 func convertField(tc typeCode, v any, cesu8Encoder transform.Transformer) (any, error) {
 	if v == nil {
 		return nil, nil
@@ -635,6 +713,12 @@ func convertField(tc typeCode, v any, cesu8Encoder transform.Transformer) (any,
 		return convertFloat(v, maxDouble)
 	case tcDate, tcTime, tcTimestamp, tcLongdate, tcSeconddate, tcDaydate, tcSecondtime:
 		return convertTime(v)
+	case tcTimetz, tcTimeltz, tcTimestampTz, tcTimestampLtz:
+		return convertTime(v)
+	case tcIntervalYm:
+		return convertInterval(v, true)
+	case tcIntervalDs:
+		return convertInterval(v, false)
 	case tcDecimal, tcFixed8, tcFixed12, tcFixed16:
 		return convertDecimal(v)
 	case tcChar, tcVarchar, tcString, tcBstring, tcAlphanum, tcNchar, tcNvarchar, tcNstring, tcShorttext, tcBinary, tcVarbinary, tcStPoint, tcStGeometry:
@@ -645,6 +729,8 @@ func convertField(tc typeCode, v any, cesu8Encoder transform.Transformer) (any,
 		return convertLob(v, cesu8Encoder)
 	case tcBintext: // ?? lobCESU8Type
 		return convertLob(v, nil)
+	case tcRealVector:
+		return convertRealVector(v)
 	default:
 		panic(fmt.Errorf("invalid type code %[1]d %[1]s", tc)) // should never happen
 	}
@@ -7,6 +7,8 @@ import (
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
 )
 
 func assertEqualBool(t *testing.T, v any, r bool) {
@@ -261,6 +263,58 @@ func testConvertBytes(t *testing.T) {
 	assertEqualBytes(t, tcBinary, &bytesValue, bytesValue)
 }
 
+func testConvertRealVector(t *testing.T) {
+	type testCustomRealVector []float32
+
+	realVectorValue := []float32{1.5, -2, 0}
+
+	for _, v := range []any{realVectorValue, testCustomRealVector(realVectorValue), &realVectorValue, []float64{1.5, -2, 0}} {
+		cv, err := convertField(tcRealVector, v, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(cv, realVectorValue) {
+			t.Fatalf("assert equal real vector failed %v - %v expected", cv, realVectorValue)
+		}
+	}
+
+	if _, err := convertField(tcRealVector, []float64{math.MaxFloat64}, nil); !errors.Is(err, errFloatOutOfRange) {
+		t.Fatalf("error %v - expected %v", err, errFloatOutOfRange)
+	}
+	if _, err := convertField(tcRealVector, "1,2", nil); !errors.Is(err, errConversionNotSupported) {
+		t.Fatalf("error %v - expected %v", err, errConversionNotSupported)
+	}
+}
+
+func testConvertInterval(t *testing.T) {
+	type testCustomDuration time.Duration
+
+	testData := []struct {
+		tc  typeCode
+		v   any
+		cv  any
+		err error
+	}{
+		{tcIntervalYm, encoding.Interval{Months: 14}, encoding.Interval{Months: 14}, nil},
+		{tcIntervalYm, encoding.Interval{Months: math.MaxInt32 + 1}, nil, errIntegerOutOfRange},
+		{tcIntervalYm, time.Hour, nil, errIntervalMismatch},
+		{tcIntervalDs, time.Hour, encoding.Interval{Duration: time.Hour}, nil},
+		{tcIntervalDs, testCustomDuration(time.Minute), encoding.Interval{Duration: time.Minute}, nil},
+		{tcIntervalDs, encoding.Interval{Months: 1}, nil, errIntervalMismatch},
+		{tcIntervalDs, "1h", nil, errConversionNotSupported},
+	}
+
+	for i, d := range testData {
+		cv, err := convertField(d.tc, d.v, nil)
+		if !errors.Is(err, d.err) {
+			t.Fatalf("%d: error %v - expected %v", i, err, d.err)
+		}
+		if d.err == nil && cv != d.cv {
+			t.Fatalf("%d: value %v - expected %v", i, cv, d.cv)
+		}
+	}
+}
+
 func TestConverter(t *testing.T) {
 	tests := []struct {
 		name string
@@ -272,6 +326,8 @@ func TestConverter(t *testing.T) {
 		{"convertTime", testConvertTime},
 		{"convertString", testConvertString},
 		{"convertBytes", testConvertBytes},
+		{"convertRealVector", testConvertRealVector},
+		{"convertInterval", testConvertInterval},
 	}
 
 	for _, test := range tests {
@@ -25,6 +25,8 @@ const (
 	DtBytes
 	DtLob
 	DtRows
+	DtRealVector
+	DtInterval
 )
 
 // RegisterScanType registers driver owned datatype scantypes (e.g. Decimal, Lob).
@@ -38,20 +40,22 @@ var scanTypes = []struct {
 	scanType     reflect.Type
 	scanNullType reflect.Type
 }{
-	DtUnknown:  {reflect.TypeFor[any](), reflect.TypeFor[any]()},
-	DtBoolean:  {reflect.TypeFor[bool](), reflect.TypeFor[sql.NullBool]()},
-	DtTinyint:  {reflect.TypeFor[uint8](), reflect.TypeFor[sql.NullByte]()},
-	DtSmallint: {reflect.TypeFor[int16](), reflect.TypeFor[sql.NullInt16]()},
-	DtInteger:  {reflect.TypeFor[int32](), reflect.TypeFor[sql.NullInt32]()},
-	DtBigint:   {reflect.TypeFor[int64](), reflect.TypeFor[sql.NullInt64]()},
-	DtReal:     {reflect.TypeFor[float32](), reflect.TypeFor[sql.NullFloat64]()},
-	DtDouble:   {reflect.TypeFor[float64](), reflect.TypeFor[sql.NullFloat64]()},
-	DtTime:     {reflect.TypeFor[time.Time](), reflect.TypeFor[sql.NullTime]()},
-	DtString:   {reflect.TypeFor[string](), reflect.TypeFor[sql.NullString]()},
-	DtBytes:    {nil, nil}, // to be registered by driver
-	DtDecimal:  {nil, nil}, // to be registered by driver
-	DtLob:      {nil, nil}, // to be registered by driver
-	DtRows:     {reflect.TypeFor[sql.Rows](), reflect.TypeFor[sql.Rows]()},
+	DtUnknown:    {reflect.TypeFor[any](), reflect.TypeFor[any]()},
+	DtBoolean:    {reflect.TypeFor[bool](), reflect.TypeFor[sql.NullBool]()},
+	DtTinyint:    {reflect.TypeFor[uint8](), reflect.TypeFor[sql.NullByte]()},
+	DtSmallint:   {reflect.TypeFor[int16](), reflect.TypeFor[sql.NullInt16]()},
+	DtInteger:    {reflect.TypeFor[int32](), reflect.TypeFor[sql.NullInt32]()},
+	DtBigint:     {reflect.TypeFor[int64](), reflect.TypeFor[sql.NullInt64]()},
+	DtReal:       {reflect.TypeFor[float32](), reflect.TypeFor[sql.NullFloat64]()},
+	DtDouble:     {reflect.TypeFor[float64](), reflect.TypeFor[sql.NullFloat64]()},
+	DtTime:       {reflect.TypeFor[time.Time](), reflect.TypeFor[sql.NullTime]()},
+	DtString:     {reflect.TypeFor[string](), reflect.TypeFor[sql.NullString]()},
+	DtBytes:      {nil, nil}, // to be registered by driver
+	DtDecimal:    {nil, nil}, // to be registered by driver
+	DtLob:        {nil, nil}, // to be registered by driver
+	DtRows:       {reflect.TypeFor[sql.Rows](), reflect.TypeFor[sql.Rows]()},
+	DtRealVector: {nil, nil}, // to be registered by driver
+	DtInterval:   {nil, nil}, // to be registered by driver
 }
 
 // ScanType returns the scan type (reflect.Type) of the corresponding data type.
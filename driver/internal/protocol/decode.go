@@ -39,6 +39,18 @@ func decodeResult(tc typeCode, d *encoding.Decoder, tr transform.Transformer, lo
 		return d.TimeField()
 	case tcTimestamp:
 		return d.TimestampField()
+	case tcTimestampTz:
+		return d.TimestampTzField()
+	case tcTimestampLtz:
+		return d.TimestampLtzField()
+	case tcTimetz:
+		return d.TimeTzField()
+	case tcTimeltz:
+		return d.TimeLtzField()
+	case tcIntervalYm:
+		return d.IntervalYmField()
+	case tcIntervalDs:
+		return d.IntervalDsField()
 	case tcLongdate:
 		return d.LongdateField()
 	case tcSeconddate:
@@ -63,6 +75,8 @@ func decodeResult(tc typeCode, d *encoding.Decoder, tr transform.Transformer, lo
 		return d.Cesu8Field()
 	case tcStPoint, tcStGeometry:
 		return d.HexField()
+	case tcRealVector:
+		return d.RealVectorField()
 	case tcBlob, tcClob, tcLocator, tcBintext:
 		descr := newLobOutDescr(nil, lobReader, lobChunkSize)
 		if descr.decode(d) {
@@ -114,6 +128,18 @@ func decodeParameter(tc typeCode, d *encoding.Decoder, scale int) (any, error) {
 		return d.TimeField()
 	case tcTimestamp:
 		return d.TimestampField()
+	case tcTimestampTz:
+		return d.TimestampTzField()
+	case tcTimestampLtz:
+		return d.TimestampLtzField()
+	case tcTimetz:
+		return d.TimeTzField()
+	case tcTimeltz:
+		return d.TimeLtzField()
+	case tcIntervalYm:
+		return d.IntervalYmField()
+	case tcIntervalDs:
+		return d.IntervalDsField()
 	case tcLongdate:
 		return d.LongdateField()
 	case tcSeconddate:
@@ -138,6 +164,8 @@ func decodeParameter(tc typeCode, d *encoding.Decoder, scale int) (any, error) {
 		return d.Cesu8Field()
 	case tcStPoint, tcStGeometry:
 		return d.HexField()
+	case tcRealVector:
+		return d.RealVectorField()
 	case tcBlob, tcClob, tcLocator, tcBintext:
 		return decodeLobParameter(d)
 	case tcText, tcNclob, tcNlocator:
@@ -49,3 +49,34 @@ func convertSecondtimeToTime(secondtime int) time.Time {
 func convertTimeToSecondtime(t time.Time) int {
 	return (t.Hour()*60+t.Minute())*60 + t.Second() + 1
 }
+
+// Time zone offset.
+func convertTzOffsetToLocation(offset int16) *time.Location {
+	return time.FixedZone("", int(offset)*60)
+}
+func convertTimeToTzOffset(t time.Time) int16 {
+	_, offset := t.Zone()
+	return int16(offset / 60) //nolint: gosec
+}
+
+// timeOfDay returns the time of day of t in location loc (date 0001-01-01 like time fields).
+func timeOfDay(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(1, 1, 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// Interval is the field value of year-month (Months) and day-second (Duration) interval fields.
+type Interval struct {
+	Months   int64
+	Duration time.Duration
+}
+
+// Day-second intervals: HDB - 7 digits precision (like longdate).
+const intervalDsTick = 100 * time.Nanosecond
+
+func convertIntervalDsToDuration(intervalDs int64) time.Duration {
+	return time.Duration(intervalDs) * intervalDsTick
+}
+func convertDurationToIntervalDs(d time.Duration) int64 {
+	return int64(d / intervalDsTick)
+}
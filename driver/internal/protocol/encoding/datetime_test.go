@@ -0,0 +1,154 @@
+package encoding
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/SAP/go-hdb/driver/unicode/cesu8"
+)
+
+type testFieldCodec struct {
+	size   int
+	encode func(e *Encoder, v any) error
+	decode func(d *Decoder) (any, error)
+}
+
+func testFieldRoundtrip(t *testing.T, codec testFieldCodec, v any) any {
+	buf := bytes.Buffer{}
+	if err := codec.encode(NewEncoder(&buf, cesu8.DefaultEncoder()), v); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != codec.size {
+		t.Fatalf("field size %d - expected %d", buf.Len(), codec.size)
+	}
+	dec := NewDecoder(&buf, cesu8.DefaultDecoder(), false)
+	rv, err := codec.decode(dec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dec.Error(); err != nil {
+		t.Fatal(err)
+	}
+	return rv
+}
+
+func testTimeZoneFields(t *testing.T) {
+	timestampTz := testFieldCodec{TimestampTzFieldSize, (*Encoder).TimestampTzField, (*Decoder).TimestampTzField}
+	timestampLtz := testFieldCodec{TimestampLtzFieldSize, (*Encoder).TimestampLtzField, (*Decoder).TimestampLtzField}
+	timeTz := testFieldCodec{TimeTzFieldSize, (*Encoder).TimeTzField, (*Decoder).TimeTzField}
+	timeLtz := testFieldCodec{TimeLtzFieldSize, (*Encoder).TimeLtzField, (*Decoder).TimeLtzField}
+
+	cet := time.FixedZone("", 60*60)
+	nst := time.FixedZone("", -(3*60+30)*60)
+
+	for _, v := range []time.Time{
+		time.Date(2024, 2, 29, 23, 30, 15, 123456700, cet),
+		time.Date(1, 1, 1, 0, 15, 0, 0, cet),
+		time.Date(9999, 12, 31, 22, 0, 0, 0, nst),
+	} {
+		_, offset := v.Zone()
+
+		rv := testFieldRoundtrip(t, timestampTz, v).(time.Time)
+		if !rv.Equal(v) {
+			t.Fatalf("timestamp tz %s - expected %s", rv, v)
+		}
+		if _, rvOffset := rv.Zone(); rvOffset != offset {
+			t.Fatalf("timestamp tz offset %d - expected %d", rvOffset, offset)
+		}
+
+		rv = testFieldRoundtrip(t, timestampLtz, v).(time.Time)
+		if !rv.Equal(v) || rv.Location() != time.Local {
+			t.Fatalf("timestamp ltz %s %s - expected %s in local time zone", rv, rv.Location(), v)
+		}
+
+		// times have millisecond precision
+		expected := time.Date(1, 1, 1, v.Hour(), v.Minute(), v.Second(), v.Nanosecond()/1e6*1e6, v.Location())
+		rv = testFieldRoundtrip(t, timeTz, v).(time.Time)
+		if rv.Hour() != expected.Hour() || rv.Minute() != expected.Minute() || rv.Second() != expected.Second() || rv.Nanosecond() != expected.Nanosecond() || rv.Year() != 1 {
+			t.Fatalf("time tz %s - expected %s", rv, expected)
+		}
+		if _, rvOffset := rv.Zone(); rvOffset != offset {
+			t.Fatalf("time tz offset %d - expected %d", rvOffset, offset)
+		}
+
+		expected = timeOfDay(expected, time.Local)
+		if rv := testFieldRoundtrip(t, timeLtz, v).(time.Time); !rv.Equal(expected) || rv.Location() != time.Local {
+			t.Fatalf("time ltz %s - expected %s", rv, expected)
+		}
+	}
+
+	// null values
+	nullTestData := []struct {
+		b      []byte
+		decode func(d *Decoder) (any, error)
+	}{
+		{append(le64(longdateNullValue), 0, 0), (*Decoder).TimestampTzField},
+		{le64(longdateNullValue), (*Decoder).TimestampLtzField},
+		{[]byte{0, 0, 0, 0, 0, 0}, (*Decoder).TimeTzField},
+		{[]byte{0, 0, 0, 0}, (*Decoder).TimeLtzField},
+	}
+	for i, d := range nullTestData {
+		dec := NewDecoder(bytes.NewBuffer(d.b), cesu8.DefaultDecoder(), false)
+		if v, err := d.decode(dec); v != nil || err != nil {
+			t.Fatalf("%d: value %v error %v - expected null value", i, v, err)
+		}
+		if dec.Cnt() != len(d.b) {
+			t.Fatalf("%d: decoded %d bytes - expected %d", i, dec.Cnt(), len(d.b))
+		}
+	}
+}
+
+func testIntervalFields(t *testing.T) {
+	intervalYm := testFieldCodec{IntervalYmFieldSize, (*Encoder).IntervalYmField, (*Decoder).IntervalYmField}
+	intervalDs := testFieldCodec{IntervalDsFieldSize, (*Encoder).IntervalDsField, (*Decoder).IntervalDsField}
+
+	for _, v := range []Interval{{Months: 0}, {Months: 14}, {Months: -25}} {
+		if rv := testFieldRoundtrip(t, intervalYm, v); rv != v {
+			t.Fatalf("year-month interval %v - expected %v", rv, v)
+		}
+	}
+	for _, v := range []Interval{{Duration: 0}, {Duration: 36*time.Hour + 1500*time.Millisecond}, {Duration: -(10*24*time.Hour + 100)}} {
+		if rv := testFieldRoundtrip(t, intervalDs, v); rv != v {
+			t.Fatalf("day-second interval %v - expected %v", rv, v)
+		}
+	}
+	// day-second intervals have 7 digits precision
+	if rv, expected := testFieldRoundtrip(t, intervalDs, Interval{Duration: time.Second + 199}), (Interval{Duration: time.Second + 100}); rv != expected {
+		t.Fatalf("day-second interval %v - expected %v", rv, expected)
+	}
+
+	// null values
+	dec := NewDecoder(bytes.NewBuffer(le32(intervalYmNullValue)), cesu8.DefaultDecoder(), false)
+	if v, err := dec.IntervalYmField(); v != nil || err != nil {
+		t.Fatalf("value %v error %v - expected null value", v, err)
+	}
+	dec = NewDecoder(bytes.NewBuffer(le64(intervalDsNullValue)), cesu8.DefaultDecoder(), false)
+	if v, err := dec.IntervalDsField(); v != nil || err != nil {
+		t.Fatalf("value %v error %v - expected null value", v, err)
+	}
+}
+
+func le32(i int32) []byte {
+	return []byte{byte(i), byte(i >> 8), byte(i >> 16), byte(i >> 24)}
+}
+
+func le64(i int64) []byte {
+	return append(le32(int32(i)), le32(int32(i>>32))...)
+}
+
+func TestDatetime(t *testing.T) {
+	tests := []struct {
+		name string
+		fct  func(t *testing.T)
+	}{
+		{"timeZoneFields", testTimeZoneFields},
+		{"intervalFields", testIntervalFields},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.fct(t)
+		})
+	}
+}
@@ -54,6 +54,13 @@ func (d *Decoder) Error() error { return d.err }
 // ResetError resets reader error.
 func (d *Decoder) ResetError() { d.err = nil }
 
+// SetReader sets the underlying reader and returns the previous one.
+func (d *Decoder) SetReader(rd io.Reader) io.Reader {
+	prev := d.rd
+	d.rd = rd
+	return prev
+}
+
 // readFull reads data from reader + read counter and error handling.
 func (d *Decoder) readFull(buf []byte) error {
 	if d.err != nil {
@@ -466,6 +473,65 @@ func (d *Decoder) SecondtimeField() (any, error) {
 	return convertSecondtimeToTime(int(secondtime)), nil
 }
 
+// TimestampTzField decodes a timestamp with time zone field.
+func (d *Decoder) TimestampTzField() (any, error) {
+	longdate := d.Int64()
+	offset := d.Int16()
+	if longdate == longdateNullValue {
+		return nil, nil
+	}
+	return convertLongdateToTime(longdate).In(convertTzOffsetToLocation(offset)), nil
+}
+
+// TimestampLtzField decodes a timestamp with local time zone field.
+// The value is returned in the time zone of the client process (time.Local) and not in the
+// time zone of the database session.
+func (d *Decoder) TimestampLtzField() (any, error) {
+	longdate := d.Int64()
+	if longdate == longdateNullValue {
+		return nil, nil
+	}
+	return convertLongdateToTime(longdate).In(time.Local), nil
+}
+
+// TimeTzField decodes a time with time zone field.
+func (d *Decoder) TimeTzField() (any, error) {
+	hour, minute, sec, nsec, null := d.decodeTime()
+	offset := d.Int16()
+	if null {
+		return nil, nil
+	}
+	return timeOfDay(time.Date(1, 1, 1, hour, minute, sec, nsec, time.UTC), convertTzOffsetToLocation(offset)), nil
+}
+
+// TimeLtzField decodes a time with local time zone field.
+// Like for TimestampLtzField the value is returned in the time zone of the client process (time.Local).
+func (d *Decoder) TimeLtzField() (any, error) {
+	hour, minute, sec, nsec, null := d.decodeTime()
+	if null {
+		return nil, nil
+	}
+	return timeOfDay(time.Date(1, 1, 1, hour, minute, sec, nsec, time.UTC), time.Local), nil
+}
+
+// IntervalYmField decodes a year-month interval field.
+func (d *Decoder) IntervalYmField() (any, error) {
+	months := d.Int32()
+	if months == intervalYmNullValue {
+		return nil, nil
+	}
+	return Interval{Months: int64(months)}, nil
+}
+
+// IntervalDsField decodes a day-second interval field.
+func (d *Decoder) IntervalDsField() (any, error) {
+	intervalDs := d.Int64()
+	if intervalDs == intervalDsNullValue {
+		return nil, nil
+	}
+	return Interval{Duration: convertIntervalDsToDuration(intervalDs)}, nil
+}
+
 // DecimalField decodes a decimal field.
 func (d *Decoder) DecimalField() (any, error) {
 	m, exp, err := d.Decimal()
@@ -566,6 +632,26 @@ func (d *Decoder) Cesu8Field() (any, error) {
 	return b, nil
 }
 
+// RealVectorField decodes a real vector field.
+func (d *Decoder) RealVectorField() (any, error) {
+	_, b := d.LIBytes()
+	if b == nil {
+		return nil, nil
+	}
+	if len(b) < IntegerFieldSize {
+		return nil, fmt.Errorf("invalid real vector size %d", len(b))
+	}
+	dim := int(binary.LittleEndian.Uint32(b))
+	if len(b) != realVectorSize(dim) {
+		return nil, fmt.Errorf("invalid real vector size %d - expected %d for dimension %d", len(b), realVectorSize(dim), dim)
+	}
+	v := make([]float32, dim)
+	for i := range v {
+		v[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[IntegerFieldSize+i*RealFieldSize:]))
+	}
+	return v, nil
+}
+
 // HexField decodes a hex field.
 func (d *Decoder) HexField() (any, error) {
 	_, b := d.LIBytes()
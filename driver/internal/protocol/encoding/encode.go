@@ -33,6 +33,13 @@ func NewEncoder(wr io.Writer, tr transform.Transformer) *Encoder {
 	}
 }
 
+// SetWriter sets the underlying writer and returns the previous one.
+func (e *Encoder) SetWriter(wr io.Writer) io.Writer {
+	prev := e.wr
+	e.wr = wr
+	return prev
+}
+
 // Zeroes encodes cnt zero byte values.
 func (e *Encoder) Zeroes(cnt int) {
 	// zero out scratch area
@@ -436,6 +443,60 @@ func (e *Encoder) SecondtimeField(v any) error {
 	return nil
 }
 
+// TimestampTzField encodes a timestamp with time zone field.
+func (e *Encoder) TimestampTzField(v any) error {
+	t, ok := v.(time.Time)
+	if !ok {
+		panic("invalid time") // should never happen
+	}
+	e.Int64(convertTimeToLongdate(t.UTC()))
+	e.Int16(convertTimeToTzOffset(t))
+	return nil
+}
+
+// TimestampLtzField encodes a timestamp with local time zone field.
+func (e *Encoder) TimestampLtzField(v any) error {
+	e.Int64(convertTimeToLongdate(asTime(v)))
+	return nil
+}
+
+// TimeTzField encodes a time with time zone field.
+func (e *Encoder) TimeTzField(v any) error {
+	t, ok := v.(time.Time)
+	if !ok {
+		panic("invalid time") // should never happen
+	}
+	e.encodeTime(t.UTC())
+	e.Int16(convertTimeToTzOffset(t))
+	return nil
+}
+
+// TimeLtzField encodes a time with local time zone field.
+func (e *Encoder) TimeLtzField(v any) error {
+	e.encodeTime(asTime(v))
+	return nil
+}
+
+func asInterval(v any) Interval {
+	i, ok := v.(Interval)
+	if !ok {
+		panic("invalid interval") // should never happen
+	}
+	return i
+}
+
+// IntervalYmField encodes a year-month interval field.
+func (e *Encoder) IntervalYmField(v any) error {
+	e.Int32(int32(asInterval(v).Months)) //nolint: gosec
+	return nil
+}
+
+// IntervalDsField encodes a day-second interval field.
+func (e *Encoder) IntervalDsField(v any) error {
+	e.Int64(convertDurationToIntervalDs(asInterval(v).Duration))
+	return nil
+}
+
 func (e *Encoder) encodeFixed(v any, size, prec, scale int) error {
 	r, ok := v.(*big.Rat)
 	if !ok {
@@ -514,6 +575,25 @@ func (e *Encoder) Cesu8Field(v any) error {
 	}
 }
 
+// RealVectorField encodes a real vector field.
+func (e *Encoder) RealVectorField(v any) error {
+	switch v := v.(type) {
+	case []float32:
+		if err := e.varFieldInd(realVectorSize(len(v))); err != nil {
+			return err
+		}
+		e.Int32(int32(len(v))) //nolint:gosec
+		for _, f := range v {
+			e.Uint32(math.Float32bits(f))
+		}
+		return nil
+	case []byte:
+		return e.LIBytes(v)
+	default:
+		panic("invalid real vector value") // should never happen
+	}
+}
+
 // HexField encodes a hex field.
 func (e *Encoder) HexField(v any) error {
 	switch v := v.(type) {
@@ -22,9 +22,12 @@ const (
 	seconddateNullValue int64 = 315538070401
 	daydateNullValue    int32 = 3652062
 	secondtimeNullValue int32 = 86402
+	intervalYmNullValue int32 = math.MinInt32
+	intervalDsNullValue int64 = math.MinInt64
 )
 
 // Field size constants.
+// The sizes of the time zone aware and interval types are not verified against captured database server data yet.
 const (
 	BooleanFieldSize       = 1
 	TinyintFieldSize       = 1
@@ -40,6 +43,12 @@ const (
 	SeconddateFieldSize    = 8
 	DaydateFieldSize       = 4
 	SecondtimeFieldSize    = 4
+	TimestampTzFieldSize   = LongdateFieldSize + 2 // utc longdate + time zone offset in minutes
+	TimestampLtzFieldSize  = LongdateFieldSize     // utc longdate
+	TimeTzFieldSize        = TimeFieldSize + 2     // utc time + time zone offset in minutes
+	TimeLtzFieldSize       = TimeFieldSize         // utc time
+	IntervalYmFieldSize    = 4
+	IntervalDsFieldSize    = 8
 	DecimalFieldSize       = 16
 	Fixed8FieldSize        = 8
 	Fixed12FieldSize       = 12
@@ -93,6 +102,21 @@ func VarFieldSize(v any) int {
 	}
 }
 
+// realVectorSize returns the size of the binary real vector format (dimension followed by the values).
+func realVectorSize(dim int) int { return IntegerFieldSize + dim*RealFieldSize }
+
+// RealVectorFieldSize returns the size of a real vector field.
+func RealVectorFieldSize(v any) int {
+	switch v := v.(type) {
+	case []float32:
+		return varFieldSize(realVectorSize(len(v)))
+	case []byte:
+		return varFieldSize(len(v))
+	default:
+		panic("invalid type for real vector field") // should never happen
+	}
+}
+
 // HexFieldSize returns the size of a hex field.
 func HexFieldSize(v any) int {
 	switch v := v.(type) {
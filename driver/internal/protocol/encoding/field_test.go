@@ -0,0 +1,54 @@
+package encoding
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/SAP/go-hdb/driver/unicode/cesu8"
+)
+
+func TestRealVectorField(t *testing.T) {
+	testData := []struct {
+		v []float32
+		b []byte
+	}{
+		{[]float32{}, []byte("\x04\x00\x00\x00\x00")},
+		{[]float32{1, -2.5}, []byte("\x0c\x02\x00\x00\x00\x00\x00\x80\x3f\x00\x00\x20\xc0")},
+	}
+
+	for i, d := range testData {
+		buf := bytes.Buffer{}
+		enc := NewEncoder(&buf, cesu8.DefaultEncoder())
+		if err := enc.RealVectorField(d.v); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf.Bytes(), d.b) {
+			t.Fatalf("%d: encoded %x - expected %x", i, buf.Bytes(), d.b)
+		}
+		if size := RealVectorFieldSize(d.v); size != len(d.b) {
+			t.Fatalf("%d: size %d - expected %d", i, size, len(d.b))
+		}
+
+		dec := NewDecoder(bytes.NewBuffer(d.b), cesu8.DefaultDecoder(), false)
+		v, err := dec.RealVectorField()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v, d.v) {
+			t.Fatalf("%d: decoded %v - expected %v", i, v, d.v)
+		}
+	}
+
+	// null value
+	dec := NewDecoder(bytes.NewBuffer([]byte{varFieldLenIndNullValue}), cesu8.DefaultDecoder(), false)
+	if v, err := dec.RealVectorField(); v != nil || err != nil {
+		t.Fatalf("value %v error %v - expected null value", v, err)
+	}
+
+	// dimension does not match size
+	dec = NewDecoder(bytes.NewBuffer([]byte("\x08\x02\x00\x00\x00\x00\x00\x80\x3f")), cesu8.DefaultDecoder(), false)
+	if _, err := dec.RealVectorField(); err == nil {
+		t.Fatal("expected invalid real vector size error")
+	}
+}
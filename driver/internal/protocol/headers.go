@@ -7,22 +7,35 @@ import (
 	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
 )
 
+// packet options.
+type packetOptions int8
+
+const (
+	poCompressed packetOptions = 0x02
+)
+
 // Message header (size: 32 bytes).
 type messageHeader struct {
-	sessionID     int64
-	packetCount   int32
-	varPartLength uint32
-	varPartSize   uint32
-	noOfSegm      int16
+	sessionID                int64
+	packetCount              int32
+	varPartLength            uint32
+	varPartSize              uint32
+	noOfSegm                 int16
+	packetOptions            packetOptions
+	compressionVarPartLength uint32 // uncompressed length of the variable part (compressed packets only)
 }
 
+func (h *messageHeader) compressed() bool { return h.packetOptions&poCompressed != 0 }
+
 func (h *messageHeader) String() string {
-	return fmt.Sprintf("session id %d packetCount %d varPartLength %d, varPartSize %d noOfSegm %d",
+	return fmt.Sprintf("session id %d packetCount %d varPartLength %d, varPartSize %d noOfSegm %d packetOptions %d compressionVarPartLength %d",
 		h.sessionID,
 		h.packetCount,
 		h.varPartLength,
 		h.varPartSize,
-		h.noOfSegm)
+		h.noOfSegm,
+		h.packetOptions,
+		h.compressionVarPartLength)
 }
 
 func (h *messageHeader) encode(enc *encoding.Encoder) error {
@@ -31,7 +44,10 @@ func (h *messageHeader) encode(enc *encoding.Encoder) error {
 	enc.Uint32(h.varPartLength)
 	enc.Uint32(h.varPartSize)
 	enc.Int16(h.noOfSegm)
-	enc.Zeroes(10) // size: 32 bytes
+	enc.Int8(int8(h.packetOptions))
+	enc.Zeroes(1) // reserved
+	enc.Uint32(h.compressionVarPartLength)
+	enc.Zeroes(4) // size: 32 bytes
 	return nil
 }
 
@@ -41,7 +57,10 @@ func (h *messageHeader) decode(dec *encoding.Decoder) error {
 	h.varPartLength = dec.Uint32()
 	h.varPartSize = dec.Uint32()
 	h.noOfSegm = dec.Int16()
-	dec.Skip(10) // size: 32 bytes
+	h.packetOptions = packetOptions(dec.Int8())
+	dec.Skip(1) // reserved
+	h.compressionVarPartLength = dec.Uint32()
+	dec.Skip(4) // size: 32 bytes
 	return dec.Error()
 }
 
@@ -58,23 +77,24 @@ const (
 	skError   segmentKind = 5
 )
 
-type commandOptions int8
+// CommandOptions represents the command options of a request segment.
+type CommandOptions int8
 
 const (
-	coNil                    commandOptions = 0x00
-	coSelfetchOff            commandOptions = 0x01
-	coScrollableCursorOn     commandOptions = 0x02
-	coNoResultsetCloseNeeded commandOptions = 0x04
-	coHoldCursorOverCommit   commandOptions = 0x08
-	coExecuteLocally         commandOptions = 0x10
+	coNil                    CommandOptions = 0x00
+	coSelfetchOff            CommandOptions = 0x01
+	CoScrollableCursorOn     CommandOptions = 0x02
+	coNoResultsetCloseNeeded CommandOptions = 0x04
+	coHoldCursorOverCommit   CommandOptions = 0x08
+	coExecuteLocally         CommandOptions = 0x10
 )
 
 var (
-	coList     = []commandOptions{coNil, coSelfetchOff, coScrollableCursorOn, coNoResultsetCloseNeeded, coHoldCursorOverCommit, coExecuteLocally}
+	coList     = []CommandOptions{coNil, coSelfetchOff, CoScrollableCursorOn, coNoResultsetCloseNeeded, coHoldCursorOverCommit, coExecuteLocally}
 	coListText = []string{"", "selfetchOff", "scrollableCursorOn", "noResultsetCloseNeeded", "holdCursorOverCommit", "executeLocally"}
 )
 
-func (k commandOptions) String() string {
+func (k CommandOptions) String() string {
 	var s []string
 
 	for i, option := range coList {
@@ -94,7 +114,7 @@ type segmentHeader struct {
 	segmentKind    segmentKind
 	messageType    MessageType
 	commit         bool
-	commandOptions commandOptions
+	commandOptions CommandOptions
 	functionCode   FunctionCode
 }
 
@@ -175,7 +195,7 @@ func (h *segmentHeader) decode(dec *encoding.Decoder) error {
 	case skRequest:
 		h.messageType = MessageType(dec.Int8())
 		h.commit = dec.Bool()
-		h.commandOptions = commandOptions(dec.Int8())
+		h.commandOptions = CommandOptions(dec.Int8())
 		dec.Skip(8) // segmentHeaderLength
 
 	case skReply:
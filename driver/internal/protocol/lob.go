@@ -2,6 +2,7 @@ package protocol
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -78,6 +79,14 @@ type LobScanner interface {
 
 var _ LobScanner = (*lobOutDescr)(nil)
 
+// LobLocator is the interface for random access to binary lob fields.
+type LobLocator interface {
+	Size() int64
+	ReadAt(ctx context.Context, p []byte, off int64) (int, error)
+}
+
+var _ LobLocator = (*lobOutDescr)(nil)
+
 // LobInDescr represents a lob input descriptor.
 type LobInDescr struct {
 	rd  io.Reader
@@ -129,6 +138,17 @@ type LobReader interface {
 	ReadLob(request *ReadLobRequest, reply *ReadLobReply) error
 }
 
+// LobLocatorReader is the interface for positioned reads (single chunk) of lobs.
+type LobLocatorReader interface {
+	ReadLobChunk(ctx context.Context, request *ReadLobRequest, reply *ReadLobReply) error
+}
+
+var (
+	errLobCharBased      = errors.New("random access is not supported for character based lobs")
+	errLobNoRandomAccess = errors.New("lob reader does not support random access")
+	errLobNegativeOffset = errors.New("negative lob offset")
+)
+
 var lobOutDescrPool = sync.Pool{New: func() any { return new(lobOutDescr) }}
 
 // lobOutDescr represents a lob output descriptor.
@@ -266,6 +286,55 @@ func (d *lobOutDescr) Write() (int, error) {
 	return n, nil
 }
 
+func (d *lobOutDescr) locatorReader() (LobLocatorReader, error) {
+	if d.tr != nil {
+		return nil, errLobCharBased
+	}
+	rd, ok := d.lobReader.(LobLocatorReader)
+	if !ok {
+		return nil, errLobNoRandomAccess
+	}
+	return rd, nil
+}
+
+// Size implements the LobLocator interface.
+func (d *lobOutDescr) Size() int64 { return d.numByte }
+
+// ReadAt implements the LobLocator interface.
+func (d *lobOutDescr) ReadAt(ctx context.Context, p []byte, off int64) (int, error) {
+	if d.tr != nil {
+		return 0, errLobCharBased
+	}
+	if off < 0 {
+		return 0, errLobNegativeOffset
+	}
+	n := 0
+	// the first chunk is part of the field value
+	if off < int64(len(d.b)) {
+		n = copy(p, d.b[off:])
+	}
+	for n < len(p) && off+int64(n) < d.numByte {
+		rd, err := d.locatorReader()
+		if err != nil {
+			return n, err
+		}
+		ofs := off + int64(n)
+		request := &ReadLobRequest{id: d.id, ofs: ofs, chunkSize: int(min(int64(len(p)-n), d.numByte-ofs, int64(d.chunkSize)))}
+		reply := &ReadLobReply{lobOutDescr: &lobOutDescr{id: d.id}}
+		if err := rd.ReadLobChunk(ctx, request, reply); err != nil {
+			return n, err
+		}
+		if len(reply.b) == 0 {
+			break
+		}
+		n += copy(p[n:], reply.b)
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
 /*
 write lobs:
 - write lob field to database in chunks
@@ -400,6 +469,17 @@ func (r *ReadLobRequest) String() string {
 	return fmt.Sprintf("id %d offset %d size %d", r.id, r.ofs, r.chunkSize)
 }
 
+// Offset returns the (0-based) offset of the read request.
+func (r *ReadLobRequest) Offset() int64 { return r.ofs }
+
+// ChunkSize returns the chunk size of the read request.
+func (r *ReadLobRequest) ChunkSize() int { return r.chunkSize }
+
+// Chunk returns a read request of the same lob for size bytes at offset ofs.
+func (r *ReadLobRequest) Chunk(ofs int64, size int) *ReadLobRequest {
+	return &ReadLobRequest{id: r.id, ofs: ofs, chunkSize: size}
+}
+
 // sniffer.
 func (r *ReadLobRequest) decode(dec *encoding.Decoder) error {
 	r.id = LocatorID(dec.Uint64())
@@ -426,6 +506,12 @@ func (r *ReadLobReply) String() string {
 	return fmt.Sprintf("id %d options %s bytes %v", r.id, r.opt, r.b)
 }
 
+// IsCharBased returns true if the lob is a character based lob.
+func (r *ReadLobReply) IsCharBased() bool { return r.tr != nil }
+
+// NumByte returns the number of lob data bytes of the reply.
+func (r *ReadLobReply) NumByte() int { return len(r.b) }
+
 // needed if instantiated generically (e.g.sniffer).
 func (r *ReadLobReply) init() {
 	r.lobOutDescr = new(lobOutDescr)
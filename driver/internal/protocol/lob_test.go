@@ -0,0 +1,94 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
+	"github.com/SAP/go-hdb/driver/unicode/cesu8"
+)
+
+// testLobLocatorReader serves lob requests from a byte slice.
+type testLobLocatorReader struct {
+	data     []byte
+	requests int
+}
+
+func (r *testLobLocatorReader) ReadLob(request *ReadLobRequest, reply *ReadLobReply) error {
+	panic("not implemented")
+}
+
+func (r *testLobLocatorReader) ReadLobChunk(ctx context.Context, request *ReadLobRequest, reply *ReadLobReply) error {
+	r.requests++
+	end := min(request.ofs+int64(request.chunkSize), int64(len(r.data)))
+	reply.b = r.data[request.ofs:end]
+	return nil
+}
+
+func TestLobLocator(t *testing.T) {
+	data := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+	rd := &testLobLocatorReader{data: data}
+
+	const chunkSize = 8
+	d := newLobOutDescr(nil, rd, chunkSize)
+	d.numByte = int64(len(data))
+	d.b = data[:10] // first chunk
+
+	testData := []struct {
+		off      int64
+		size     int
+		requests int
+		err      error
+	}{
+		{0, 5, 0, nil},  // first chunk
+		{8, 10, 1, nil}, // first chunk and one request
+		{20, 16, 2, nil},
+		{30, 10, 1, io.EOF}, // beyond end
+	}
+
+	for i, td := range testData {
+		rd.requests = 0
+		b := make([]byte, td.size)
+		n, err := d.ReadAt(t.Context(), b, td.off)
+		if !errors.Is(err, td.err) {
+			t.Fatalf("%d: error %v - expected %v", i, err, td.err)
+		}
+		if expected := data[td.off:min(td.off+int64(td.size), int64(len(data)))]; !bytes.Equal(b[:n], expected) {
+			t.Fatalf("%d: data %s - expected %s", i, b[:n], expected)
+		}
+		if rd.requests != td.requests {
+			t.Fatalf("%d: requests %d - expected %d", i, rd.requests, td.requests)
+		}
+	}
+
+	// character based lobs
+	c := newLobOutDescr(cesu8.DefaultDecoder(), rd, chunkSize)
+	if _, err := c.ReadAt(t.Context(), make([]byte, 1), 0); !errors.Is(err, errLobCharBased) {
+		t.Fatalf("error %v - expected %v", err, errLobCharBased)
+	}
+}
+
+func TestReadLobRequestChunk(t *testing.T) {
+	request := &ReadLobRequest{id: 42, ofs: 10, chunkSize: 1000}
+	chunk := request.Chunk(5000, 300)
+	if chunk.id != request.id || chunk.Offset() != 5000 || chunk.ChunkSize() != 300 {
+		t.Fatalf("chunk request %s - expected id %d offset %d size %d", chunk, request.id, 5000, 300)
+	}
+	if request.Offset() != 10 || request.ChunkSize() != 1000 {
+		t.Fatalf("request %s modified", request)
+	}
+	buf := bytes.Buffer{}
+	if err := chunk.encode(encoding.NewEncoder(&buf, cesu8.DefaultEncoder())); err != nil {
+		t.Fatal(err)
+	}
+	decoded := &ReadLobRequest{}
+	if err := decoded.decode(encoding.NewDecoder(&buf, cesu8.DefaultDecoder(), false)); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.id != chunk.id || decoded.ofs != chunk.ofs+1 || decoded.chunkSize != chunk.chunkSize { // sniffer decodes 1-based offset
+		t.Fatalf("request %s - expected %s", decoded, chunk)
+	}
+}
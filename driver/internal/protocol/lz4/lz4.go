@@ -0,0 +1,187 @@
+// Package lz4 implements the LZ4 block format as used by the hdb network compression.
+// The format is described in https://github.com/lz4/lz4/blob/dev/doc/lz4_Block_format.md.
+package lz4
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	minMatch     = 4
+	mfLimit      = 12 // the last match must start at least 12 bytes before the end of the block
+	lastLiterals = 5  // the last 5 bytes are always literals
+	maxOffset    = 65535
+
+	hashLog   = 14
+	hashShift = 32 - hashLog
+)
+
+// ErrCorrupt is returned if the compressed data is invalid.
+var ErrCorrupt = errors.New("lz4: corrupt input")
+
+func hash(v uint32) uint32 { return (v * 2654435761) >> hashShift }
+
+// A Compressor compresses data blocks.
+// The zero value is ready to use and a Compressor can be reused for subsequent blocks.
+type Compressor struct {
+	table [1 << hashLog]int32 // position + 1 of the last occurrence of a hash value (0: none)
+}
+
+func appendLen(dst []byte, n int) []byte {
+	for n >= 0xff {
+		dst = append(dst, 0xff)
+		n -= 0xff
+	}
+	return append(dst, byte(n))
+}
+
+func appendSequence(dst, literals []byte, offset, matchLen int) []byte {
+	var token byte
+	numLiteral := len(literals)
+	if numLiteral >= 0x0f {
+		token = 0xf0
+	} else {
+		token = byte(numLiteral << 4)
+	}
+	if matchLen >= 0x0f {
+		token |= 0x0f
+	} else {
+		token |= byte(matchLen)
+	}
+	dst = append(dst, token)
+	if numLiteral >= 0x0f {
+		dst = appendLen(dst, numLiteral-0x0f)
+	}
+	dst = append(dst, literals...)
+	dst = append(dst, byte(offset), byte(offset>>8))
+	if matchLen >= 0x0f {
+		dst = appendLen(dst, matchLen-0x0f)
+	}
+	return dst
+}
+
+func appendLastLiterals(dst, literals []byte) []byte {
+	numLiteral := len(literals)
+	if numLiteral >= 0x0f {
+		dst = append(dst, 0xf0)
+		dst = appendLen(dst, numLiteral-0x0f)
+	} else {
+		dst = append(dst, byte(numLiteral<<4))
+	}
+	return append(dst, literals...)
+}
+
+// Compress appends the compressed block of src to dst and returns the extended buffer.
+func (c *Compressor) Compress(dst, src []byte) []byte {
+	clear(c.table[:])
+
+	n := len(src)
+	anchor := 0
+
+	if n > mfLimit {
+		limit := n - mfLimit
+		for i := 0; i < limit; {
+			seq := binary.LittleEndian.Uint32(src[i:])
+			h := hash(seq)
+			ref := int(c.table[h]) - 1
+			c.table[h] = int32(i + 1) //nolint: gosec
+			if ref < 0 || i-ref > maxOffset || binary.LittleEndian.Uint32(src[ref:]) != seq {
+				i++
+				continue
+			}
+			// extend match backwards
+			for i > anchor && ref > 0 && src[i-1] == src[ref-1] {
+				i--
+				ref--
+			}
+			// extend match forwards
+			end := i + minMatch
+			for end < n-lastLiterals && src[end] == src[ref+end-i] {
+				end++
+			}
+			dst = appendSequence(dst, src[anchor:i], i-ref, end-i-minMatch)
+			i = end
+			anchor = end
+		}
+	}
+	return appendLastLiterals(dst, src[anchor:])
+}
+
+func readLen(src []byte, si int) (int, int, error) {
+	n := 0
+	for {
+		if si >= len(src) {
+			return 0, 0, ErrCorrupt
+		}
+		b := src[si]
+		si++
+		n += int(b)
+		if b != 0xff {
+			return n, si, nil
+		}
+	}
+}
+
+// Decompress decompresses the block src into dst and returns the number of bytes written to dst.
+// dst needs to be large enough to hold the uncompressed data.
+func Decompress(dst, src []byte) (int, error) {
+	si, di := 0, 0
+	for si < len(src) {
+		token := src[si]
+		si++
+
+		numLiteral := int(token >> 4)
+		if numLiteral == 0x0f {
+			n, i, err := readLen(src, si)
+			if err != nil {
+				return 0, err
+			}
+			numLiteral += n
+			si = i
+		}
+		if si+numLiteral > len(src) || di+numLiteral > len(dst) {
+			return 0, ErrCorrupt
+		}
+		di += copy(dst[di:], src[si:si+numLiteral])
+		si += numLiteral
+
+		if si == len(src) { // last sequence
+			return di, nil
+		}
+
+		if si+2 > len(src) {
+			return 0, ErrCorrupt
+		}
+		offset := int(src[si]) | int(src[si+1])<<8
+		si += 2
+		if offset == 0 || offset > di {
+			return 0, ErrCorrupt
+		}
+
+		matchLen := int(token & 0x0f)
+		if matchLen == 0x0f {
+			n, i, err := readLen(src, si)
+			if err != nil {
+				return 0, err
+			}
+			matchLen += n
+			si = i
+		}
+		matchLen += minMatch
+		if di+matchLen > len(dst) {
+			return 0, ErrCorrupt
+		}
+
+		ref := di - offset
+		if offset >= matchLen {
+			di += copy(dst[di:di+matchLen], dst[ref:ref+matchLen])
+		} else { // overlapping copy
+			for i := range matchLen {
+				dst[di+i] = dst[ref+i]
+			}
+			di += matchLen
+		}
+	}
+	return di, nil
+}
@@ -0,0 +1,79 @@
+package lz4
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"testing"
+)
+
+func testRoundtrip(t *testing.T, src []byte) {
+	c := new(Compressor)
+	compressed := c.Compress(nil, src)
+
+	dst := make([]byte, len(src))
+	n, err := Decompress(dst, compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len(src) {
+		t.Fatalf("decompressed length %d - expected %d", n, len(src))
+	}
+	if !bytes.Equal(dst, src) {
+		t.Fatal("decompressed data differs from source")
+	}
+}
+
+func TestRoundtrip(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2)) //nolint:gosec
+
+	random := make([]byte, 100000)
+	for i := range random {
+		random[i] = byte(r.UintN(256))
+	}
+
+	text := bytes.Repeat([]byte("select * from dummy where a = ? and b = ?; "), 1000)
+
+	testData := []struct {
+		name string
+		src  []byte
+	}{
+		{"empty", []byte{}},
+		{"short", []byte("hello")},
+		{"mflimit", []byte("abcdabcdabcda")},
+		{"zeroes", make([]byte, 70000)},
+		{"text", text},
+		{"random", random},
+		{"mixed", append(append([]byte{}, random[:1000]...), text...)},
+	}
+
+	for _, d := range testData {
+		t.Run(d.name, func(t *testing.T) {
+			testRoundtrip(t, d.src)
+		})
+	}
+}
+
+func TestCompressionRatio(t *testing.T) {
+	src := make([]byte, 100000)
+	c := new(Compressor)
+	if compressed := c.Compress(nil, src); len(compressed) > len(src)/100 {
+		t.Fatalf("compressed length %d exceeds expected maximum %d", len(compressed), len(src)/100)
+	}
+}
+
+func TestDecompressCorrupt(t *testing.T) {
+	testData := [][]byte{
+		{0xf0},                  // missing literal length
+		{0x20, 'a'},             // literals exceed input
+		{0x10, 'a', 0x02, 0x00}, // offset exceeds output
+		{0x10, 'a', 0x00, 0x00}, // zero offset
+		{0x10, 'a', 0x01},       // incomplete offset
+	}
+
+	dst := make([]byte, 100)
+	for i, src := range testData {
+		if _, err := Decompress(dst, src); err != ErrCorrupt { //nolint:errorlint
+			t.Fatalf("test %d: error %v - expected %v", i, err, ErrCorrupt)
+		}
+	}
+}
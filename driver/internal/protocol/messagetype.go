@@ -22,23 +22,23 @@ const (
 	MtCloseResultset  MessageType = 69
 	MtDropStatementID MessageType = 70
 	MtFetchNext       MessageType = 71
-	mtFetchAbsolute   MessageType = 72
-	mtFetchRelative   MessageType = 73
-	mtFetchFirst      MessageType = 74
-	mtFetchLast       MessageType = 75
+	MtFetchAbsolute   MessageType = 72
+	MtFetchRelative   MessageType = 73
+	MtFetchFirst      MessageType = 74
+	MtFetchLast       MessageType = 75
 	MtDisconnect      MessageType = 77
 	mtExecuteITab     MessageType = 78
 	mtFetchNextITab   MessageType = 79
 	mtInsertNextITab  MessageType = 80
 	mtBatchPrepare    MessageType = 81
 	MtDBConnectInfo   MessageType = 82
-	mtXopenXAStart    MessageType = 83
-	mtXopenXAEnd      MessageType = 84
-	mtXopenXAPrepare  MessageType = 85
-	mtXopenXACommit   MessageType = 86
-	mtXopenXARollback MessageType = 87
-	mtXopenXARecover  MessageType = 88
-	mtXopenXAForget   MessageType = 89
+	MtXopenXAStart    MessageType = 83
+	MtXopenXAEnd      MessageType = 84
+	MtXopenXAPrepare  MessageType = 85
+	MtXopenXACommit   MessageType = 86
+	MtXopenXARollback MessageType = 87
+	MtXopenXARecover  MessageType = 88
+	MtXopenXAForget   MessageType = 89
 )
 
 // ClientInfoSupported returns true if message does support client info, false otherwise.
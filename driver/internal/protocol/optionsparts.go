@@ -152,6 +152,40 @@ func (co *ConnectOptions) SetClientDistributionMode(v Cdm) {
 	co.options.set(coClientDistributionMode, int32(v))
 }
 
+// ConnectionIDOrZero returns the connection id option if available, the zero value otherwise.
+func (co *ConnectOptions) ConnectionIDOrZero() int {
+	var v int32
+	co.options.get(coConnectionID, &v)
+	return int(v)
+}
+
+// SetOriginalAnchorConnectionID sets the original anchor connection id option.
+func (co *ConnectOptions) SetOriginalAnchorConnectionID(v int) {
+	co.options.set(coOriginalAnchorConnectionID, int32(v)) //nolint: gosec
+}
+
+// SetAssociatedConnectionID sets the associated connection id option.
+func (co *ConnectOptions) SetAssociatedConnectionID(v int) {
+	co.options.set(coAssociatedConnectionID, int32(v)) //nolint: gosec
+}
+
+// ActiveActiveProtocolVersionOrZero returns the Active/Active protocol version option if available, the zero value otherwise.
+func (co *ConnectOptions) ActiveActiveProtocolVersionOrZero() int {
+	var v int32
+	co.options.get(coActiveActiveProtocolVersion, &v)
+	return int(v)
+}
+
+// SetActiveActiveProtocolVersion sets the Active/Active protocol version option.
+func (co *ConnectOptions) SetActiveActiveProtocolVersion(v int) {
+	co.options.set(coActiveActiveProtocolVersion, int32(v)) //nolint: gosec
+}
+
+// SetActiveActiveConnectionOriginSite sets the site the anchor connection of an Active/Active connection is located at.
+func (co *ConnectOptions) SetActiveActiveConnectionOriginSite(v SiteType) {
+	co.options.set(coActiveActiveConnectionOriginSite, int32(v))
+}
+
 // SetSelectForUpdateSupported sets the select for update supported option.
 func (co *ConnectOptions) SetSelectForUpdateSupported(v bool) {
 	co.options.set(coSelectForUpdateSupported, v)
@@ -171,9 +205,45 @@ func (co *ConnectOptions) FullVersionOrZero() string {
 	return v
 }
 
+// QueryTimeoutSupportedOrZero returns the query timeout supported option if available, the zero value otherwise.
+func (co *ConnectOptions) QueryTimeoutSupportedOrZero() bool {
+	var v bool
+	co.options.get(coQueryTimeoutSupported, &v)
+	return v
+}
+
+// SetQueryTimeoutSupported sets the query timeout supported option.
+func (co *ConnectOptions) SetQueryTimeoutSupported(v bool) {
+	co.options.set(coQueryTimeoutSupported, v)
+}
+
+// SetXOpenXAProtocolSupported sets the X/Open XA protocol supported option.
+func (co *ConnectOptions) SetXOpenXAProtocolSupported(v bool) {
+	co.options.set(coXOpenXAProtocolSupported, v)
+}
+
 // SetClientLocale sets the client locale option.
 func (co *ConnectOptions) SetClientLocale(v string) { co.options.set(coClientLocale, v) }
 
+// compressionLevelAndFlags is the network compression option value requesting the default (lz4) compression.
+const compressionLevelAndFlags int32 = 1
+
+// SetCompression sets the network compression option.
+func (co *ConnectOptions) SetCompression(v bool) {
+	if v {
+		co.options.set(coCompressionLevelAndFlags, compressionLevelAndFlags)
+	} else {
+		delete(co.options, coCompressionLevelAndFlags)
+	}
+}
+
+// CompressionOrZero returns true if the network compression option is available and set, the zero value otherwise.
+func (co *ConnectOptions) CompressionOrZero() bool {
+	var v int32
+	co.options.get(coCompressionLevelAndFlags, &v)
+	return v != 0
+}
+
 // DBConnectInfoType represents a database connect info type.
 type dbConnectInfoType int8
 
@@ -227,10 +297,35 @@ const (
 	scServerMemoryUsage             statementContextType = 8
 )
 
-type statementContext struct {
+// StatementContext represents a statement context part.
+type StatementContext struct {
 	options[statementContextType]
 }
 
+// ServerProcessingTimeOrZero returns the server processing time option (microseconds) if available, the zero value otherwise.
+func (sc *StatementContext) ServerProcessingTimeOrZero() int64 {
+	var v int64
+	sc.options.get(scServerProcessingTime, &v)
+	return v
+}
+
+// ServerCPUTimeOrZero returns the server cpu time option (microseconds) if available, the zero value otherwise.
+func (sc *StatementContext) ServerCPUTimeOrZero() int64 {
+	var v int64
+	sc.options.get(scServerCPUTime, &v)
+	return v
+}
+
+// SetQueryTimeout sets the query timeout option (milliseconds).
+func (sc *StatementContext) SetQueryTimeout(v int64) { sc.options.set(scQueryTimeout, v) }
+
+// ServerMemoryUsageOrZero returns the server memory usage option (bytes) if available, the zero value otherwise.
+func (sc *StatementContext) ServerMemoryUsageOrZero() int64 {
+	var v int64
+	sc.options.get(scServerMemoryUsage, &v)
+	return v
+}
+
 // transaction flags.
 type transactionFlagType int8
 
@@ -254,6 +349,27 @@ type transactionFlags struct {
 	options[transactionFlagType]
 }
 
+// fetch options.
+type fetchOptionType int8
+
+func (k fetchOptionType) valueString(v any) string {
+	return fmt.Sprintf("%s: %v", k, v)
+}
+
+const (
+	foResultsetPos fetchOptionType = 1 // position of fetch (int4)
+)
+
+// FetchOptions represents a fetch options part.
+type FetchOptions struct {
+	options[fetchOptionType]
+}
+
+// SetResultsetPos sets the result set position option used by absolute and relative fetches.
+func (fo *FetchOptions) SetResultsetPos(v int) {
+	fo.options.set(foResultsetPos, int32(v)) //nolint: gosec
+}
+
 type topologyOption int8
 
 func (k topologyOption) valueString(v any) string {
@@ -301,6 +417,17 @@ const (
 	StScriptServer     ServiceType = 11
 )
 
+// SiteType represents the system replication site type of a database host.
+type SiteType int32
+
+// Site type constants.
+const (
+	SiteTypeNone      SiteType = 0
+	SiteTypePrimary   SiteType = 1
+	SiteTypeSecondary SiteType = 2
+	SiteTypeTertiary  SiteType = 3
+)
+
 // TopologyInformation represents a topology information part.
 type TopologyInformation struct {
 	hosts []*options[topologyOption]
@@ -308,6 +435,44 @@ type TopologyInformation struct {
 
 func (ti TopologyInformation) String() string { return fmt.Sprintf("%v", ti.hosts) }
 
+// TopologyHost represents the topology information of a database host.
+type TopologyHost struct {
+	Host             string
+	Port             int
+	VolumeID         int
+	IsPrimary        bool
+	IsCurrentSession bool
+	ServiceType      ServiceType
+	SiteType         SiteType
+}
+
+// Hosts returns the topology information of the database hosts.
+func (ti *TopologyInformation) Hosts() []*TopologyHost {
+	hosts := make([]*TopologyHost, 0, len(ti.hosts))
+	for _, host := range ti.hosts {
+		var hostName string
+		var port, volumeID, serviceType, siteType int32
+		var isPrimary, isCurrentSession bool
+		host.get(toHostName, &hostName)
+		host.get(toHostPortnumber, &port)
+		host.get(toVolumeID, &volumeID)
+		host.get(toIsPrimary, &isPrimary)
+		host.get(toIsCurrentSession, &isCurrentSession)
+		host.get(toServiceType, &serviceType)
+		host.get(toSiteType, &siteType)
+		hosts = append(hosts, &TopologyHost{
+			Host:             hostName,
+			Port:             int(port),
+			VolumeID:         int(volumeID),
+			IsPrimary:        isPrimary,
+			IsCurrentSession: isCurrentSession,
+			ServiceType:      ServiceType(serviceType),
+			SiteType:         SiteType(siteType),
+		})
+	}
+	return hosts
+}
+
 func (ti *TopologyInformation) decodeNumArg(dec *encoding.Decoder, numArg int) error {
 	ti.hosts = resizeSlice(ti.hosts, numArg)
 	for i := range numArg {
@@ -346,17 +511,20 @@ func (ops *options[K]) get(k K, v any) bool {
 	if !ok {
 		return false
 	}
+	// option values are sent by the server - a type mismatch leaves the zero value.
 	switch v := v.(type) {
 	case *string:
-		*v = mv.(string)
+		*v, ok = mv.(string)
 	case *bool:
-		*v = mv.(bool)
+		*v, ok = mv.(bool)
 	case *int32:
-		*v = mv.(int32)
+		*v, ok = mv.(int32)
+	case *int64:
+		*v, ok = mv.(int64)
 	default:
 		panic("invalid option type")
 	}
-	return true
+	return ok
 }
 
 func (ops *options[K]) set(k K, v any) {
@@ -201,6 +201,18 @@ func (f *ParameterField) prmSize(v any) int {
 		return encoding.TimeFieldSize
 	case tcTimestamp:
 		return encoding.TimestampFieldSize
+	case tcTimestampTz:
+		return encoding.TimestampTzFieldSize
+	case tcTimestampLtz:
+		return encoding.TimestampLtzFieldSize
+	case tcTimetz:
+		return encoding.TimeTzFieldSize
+	case tcTimeltz:
+		return encoding.TimeLtzFieldSize
+	case tcIntervalYm:
+		return encoding.IntervalYmFieldSize
+	case tcIntervalDs:
+		return encoding.IntervalDsFieldSize
 	case tcLongdate:
 		return encoding.LongdateFieldSize
 	case tcSeconddate:
@@ -223,6 +235,8 @@ func (f *ParameterField) prmSize(v any) int {
 		return encoding.Cesu8FieldSize(v)
 	case tcStPoint, tcStGeometry:
 		return encoding.HexFieldSize(v)
+	case tcRealVector:
+		return encoding.RealVectorFieldSize(v)
 	case tcBlob, tcClob, tcLocator, tcNclob, tcText, tcNlocator, tcBintext:
 		return encoding.LobInputParametersSize
 	default:
@@ -258,6 +272,18 @@ func (f *ParameterField) encodePrm(enc *encoding.Encoder, v any) error {
 		return enc.TimeField(v)
 	case tcTimestamp:
 		return enc.TimestampField(v)
+	case tcTimestampTz:
+		return enc.TimestampTzField(v)
+	case tcTimestampLtz:
+		return enc.TimestampLtzField(v)
+	case tcTimetz:
+		return enc.TimeTzField(v)
+	case tcTimeltz:
+		return enc.TimeLtzField(v)
+	case tcIntervalYm:
+		return enc.IntervalYmField(v)
+	case tcIntervalDs:
+		return enc.IntervalDsField(v)
 	case tcLongdate:
 		return enc.LongdateField(v)
 	case tcSeconddate:
@@ -280,6 +306,8 @@ func (f *ParameterField) encodePrm(enc *encoding.Encoder, v any) error {
 		return enc.Cesu8Field(v)
 	case tcStPoint, tcStGeometry:
 		return enc.HexField(v)
+	case tcRealVector:
+		return enc.RealVectorField(v)
 	case tcBlob, tcClob, tcLocator, tcNclob, tcText, tcNlocator, tcBintext:
 		descr, ok := v.(*LobInDescr)
 		if !ok {
@@ -14,7 +14,7 @@ const (
 	pkRowsAffected              PartKind = 12
 	PkResultsetID               PartKind = 13
 	PkTopologyInformation       PartKind = 15
-	pkTableLocation             PartKind = 16
+	PkTableLocation             PartKind = 16
 	PkReadLobRequest            PartKind = 17
 	PkReadLobReply              PartKind = 18
 	pkAbapIStream               PartKind = 25
@@ -33,7 +33,7 @@ const (
 	PkOutputParameters          PartKind = 41
 	PkConnectOptions            PartKind = 42
 	pkCommitOptions             PartKind = 43
-	pkFetchOptions              PartKind = 44
+	PkFetchOptions              PartKind = 44
 	PkFetchSize                 PartKind = 45
 	PkParameterMetadata         PartKind = 47
 	PkResultMetadata            PartKind = 48
@@ -56,7 +56,7 @@ const (
 	PkDBConnectInfo             PartKind = 67
 	pkLobFlags                  PartKind = 68
 	pkResultsetOptions          PartKind = 69
-	pkXATransactionInfo         PartKind = 70
+	PkXATransactionInfo         PartKind = 70
 	pkSessionVariable           PartKind = 71
 	pkWorkLoadReplayContext     PartKind = 72
 	pkSQLReplyOptions           PartKind = 73
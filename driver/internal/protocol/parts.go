@@ -63,8 +63,11 @@ func (*WriteLobReply) kind() PartKind       { return PkWriteLobReply }
 func (*ClientContext) kind() PartKind       { return PkClientContext }
 func (*ConnectOptions) kind() PartKind      { return PkConnectOptions }
 func (*DBConnectInfo) kind() PartKind       { return PkDBConnectInfo }
-func (*statementContext) kind() PartKind    { return PkStatementContext }
+func (*StatementContext) kind() PartKind    { return PkStatementContext }
 func (*transactionFlags) kind() PartKind    { return PkTransactionFlags }
+func (*FetchOptions) kind() PartKind        { return PkFetchOptions }
+func (*XATransactionInfo) kind() PartKind   { return PkXATransactionInfo }
+func (TableLocation) kind() PartKind        { return PkTableLocation }
 
 // numArg methods (result == 1).
 func (*AuthInitRequest) numArg() int  { return 1 }
@@ -107,6 +110,9 @@ var (
 	_ PartEncoder = (*ClientContext)(nil)
 	_ PartEncoder = (*ConnectOptions)(nil)
 	_ PartEncoder = (*DBConnectInfo)(nil)
+	_ PartEncoder = (*FetchOptions)(nil)
+	_ PartEncoder = (*XATransactionInfo)(nil)
+	_ PartEncoder = (*StatementContext)(nil)
 )
 
 // check if part types implement the right part decoder interface.
@@ -136,8 +142,11 @@ var (
 	_ numArgPartDecoder = (*ClientContext)(nil)
 	_ numArgPartDecoder = (*ConnectOptions)(nil)
 	_ numArgPartDecoder = (*DBConnectInfo)(nil)
-	_ numArgPartDecoder = (*statementContext)(nil)
+	_ numArgPartDecoder = (*StatementContext)(nil)
 	_ numArgPartDecoder = (*transactionFlags)(nil)
+	_ numArgPartDecoder = (*FetchOptions)(nil)
+	_ numArgPartDecoder = (*XATransactionInfo)(nil)
+	_ numArgPartDecoder = (*TableLocation)(nil)
 )
 
 var genPartTypeMap = map[PartKind]reflect.Type{
@@ -157,8 +166,11 @@ var genPartTypeMap = map[PartKind]reflect.Type{
 	PkClientContext:       reflect.TypeFor[ClientContext](),
 	PkConnectOptions:      reflect.TypeFor[ConnectOptions](),
 	PkTransactionFlags:    reflect.TypeFor[transactionFlags](),
-	PkStatementContext:    reflect.TypeFor[statementContext](),
+	PkStatementContext:    reflect.TypeFor[StatementContext](),
 	PkDBConnectInfo:       reflect.TypeFor[DBConnectInfo](),
+	PkFetchOptions:        reflect.TypeFor[FetchOptions](),
+	PkXATransactionInfo:   reflect.TypeFor[XATransactionInfo](),
+	PkTableLocation:       reflect.TypeFor[TableLocation](),
 	/*
 	   parts that cannot be used generically as additional parameters are needed
 
@@ -2,14 +2,17 @@ package protocol
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"math"
 	"reflect"
 
 	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
+	"github.com/SAP/go-hdb/driver/internal/protocol/lz4"
 	"golang.org/x/text/transform"
 )
 
@@ -30,6 +33,9 @@ const (
 // padding.
 const padding = 8
 
+// compressionThreshold is the minimal variable part length of a message to be compressed.
+const compressionThreshold = 10 * 1024
+
 func padBytes(size int) int {
 	if r := size % padding; r != 0 {
 		return padding - r
@@ -85,6 +91,13 @@ type Reader struct {
 
 	hdbErrors    *HdbErrors
 	rowsAffected *rowsAffected
+	stmtCtx      *StatementContext
+	hasStmtCtx   bool
+
+	// decompression
+	crd  bytes.Reader
+	buf  []byte
+	cbuf []byte
 }
 
 func newReader(dec *encoding.Decoder, tr transform.Transformer, protTrace bool, logger *slog.Logger, lobChunkSize int, readFromDB bool, prefix string) *Reader {
@@ -102,6 +115,7 @@ func newReader(dec *encoding.Decoder, tr transform.Transformer, protTrace bool,
 		ph:           &partHeader{},
 		hdbErrors:    &HdbErrors{},
 		rowsAffected: &rowsAffected{},
+		stmtCtx:      &StatementContext{},
 	}
 }
 
@@ -124,6 +138,9 @@ func (r *Reader) SkipParts(ctx context.Context) error {
 // SessionID returns the session ID.
 func (r *Reader) SessionID() int64 { return r.mh.sessionID }
 
+// StatementContext returns the statement context of the last read message if available.
+func (r *Reader) StatementContext() (*StatementContext, bool) { return r.stmtCtx, r.hasStmtCtx }
+
 // FunctionCode returns the function code of the protocol.
 func (r *Reader) FunctionCode() FunctionCode { return r.sh.functionCode }
 
@@ -205,6 +222,42 @@ func (r *Reader) ReadPart(ctx context.Context, part Part, lobReader LobReader) (
 	return err
 }
 
+const (
+	// maxMessageSize is the maximum size of the variable part of a message (segment and part lengths are int32 values).
+	maxMessageSize = math.MaxInt32
+	// lz4MaxRatio is the maximum compression ratio of lz4 compressed data.
+	lz4MaxRatio = 255
+)
+
+// inflate reads and decompresses the variable part of a compressed message,
+// switches the decoder to the uncompressed data and returns the previous decoder reader.
+func (r *Reader) inflate() (io.Reader, error) {
+	// check lengths before allocating the buffers
+	if r.mh.varPartLength > maxMessageSize || r.mh.compressionVarPartLength > maxMessageSize {
+		return nil, fmt.Errorf("protocol error: compressed message size %d uncompressed size %d exceeds maximum size %d", r.mh.varPartLength, r.mh.compressionVarPartLength, maxMessageSize)
+	}
+	if uint64(r.mh.compressionVarPartLength) > uint64(r.mh.varPartLength)*lz4MaxRatio {
+		return nil, fmt.Errorf("protocol error: uncompressed message size %d exceeds maximum size %d of compressed size %d", r.mh.compressionVarPartLength, uint64(r.mh.varPartLength)*lz4MaxRatio, r.mh.varPartLength)
+	}
+	r.cbuf = resizeSlice(r.cbuf, int(r.mh.varPartLength))
+	r.dec.Bytes(r.cbuf)
+	if err := r.dec.Error(); err != nil {
+		return nil, err
+	}
+	r.buf = resizeSlice(r.buf, int(r.mh.compressionVarPartLength))
+	n, err := lz4.Decompress(r.buf, r.cbuf)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(r.buf) {
+		return nil, fmt.Errorf("protocol error: decompressed length %d - expected %d", n, len(r.buf))
+	}
+	// from here on the variable part length relates to the uncompressed data
+	r.mh.varPartLength = r.mh.compressionVarPartLength
+	r.crd.Reset(r.buf)
+	return r.dec.SetReader(&r.crd), nil
+}
+
 // ErrSkipped is used by the caller of the iterator to indicate that no read was executed.
 var ErrSkipped = errors.New("parts iterator: skipped read")
 
@@ -213,6 +266,8 @@ func (r *Reader) IterateParts(ctx context.Context, offset int, fn func(kind Part
 	var hdbErrors *HdbErrors
 	var rowsAffected *rowsAffected
 
+	r.hasStmtCtx = false
+
 	if err := r.mh.decode(r.dec); err != nil {
 		return 0, err
 	}
@@ -222,6 +277,14 @@ func (r *Reader) IterateParts(ctx context.Context, offset int, fn func(kind Part
 		r.logger.LogAttrs(ctx, slog.LevelInfo, traceMsg, slog.String(r.prefix+textMsgHdr, r.mh.String()))
 	}
 
+	if r.mh.compressed() {
+		rd, err := r.inflate()
+		if err != nil {
+			return 0, err
+		}
+		defer r.dec.SetReader(rd)
+	}
+
 	for range int(r.mh.noOfSegm) {
 		if err := r.sh.decode(r.dec); err != nil {
 			return 0, err
@@ -259,6 +322,11 @@ func (r *Reader) IterateParts(ctx context.Context, offset int, fn func(kind Part
 					return 0, err
 				}
 				hdbErrors = r.hdbErrors
+			case PkStatementContext:
+				if err := r.ReadPart(ctx, r.stmtCtx, nil); err != nil {
+					return 0, err
+				}
+				r.hasStmtCtx = true
 			default:
 				err := ErrSkipped
 				// caller must not handle hdb errors and rows affected.
@@ -348,6 +416,12 @@ type Writer struct {
 	ph *partHeader
 
 	hasError bool
+
+	// compression
+	compression bool
+	compressor  *lz4.Compressor
+	buf         bytes.Buffer
+	cbuf        []byte
 }
 
 // NewWriter returns an instance of a protocol writer.
@@ -396,15 +470,29 @@ func (w *Writer) WriteProlog(ctx context.Context) error {
 // SetSessionID sets the session ID after a successful authentication.
 func (w *Writer) SetSessionID(sessionID int64) { w.sessionID = sessionID }
 
+// SetCompression enables or disables the compression of messages.
+// Compression should only be enabled after the server did accept it while connecting.
+func (w *Writer) SetCompression(compression bool) {
+	w.compression = compression
+	if compression && w.compressor == nil {
+		w.compressor = new(lz4.Compressor)
+	}
+}
+
 func (w *Writer) Write(ctx context.Context, messageType MessageType, commit bool, parts ...PartEncoder) error {
-	err := w._write(ctx, messageType, commit, parts...)
+	return w.WriteWithCommandOptions(ctx, messageType, commit, coNil, parts...)
+}
+
+// WriteWithCommandOptions writes a message setting the command options of the request segment.
+func (w *Writer) WriteWithCommandOptions(ctx context.Context, messageType MessageType, commit bool, commandOptions CommandOptions, parts ...PartEncoder) error {
+	err := w._write(ctx, messageType, commit, commandOptions, parts...)
 	if err != nil {
 		w.hasError = true
 	}
 	return err
 }
 
-func (w *Writer) _write(ctx context.Context, messageType MessageType, commit bool, parts ...PartEncoder) error {
+func (w *Writer) _write(ctx context.Context, messageType MessageType, commit bool, commandOptions CommandOptions, parts ...PartEncoder) error {
 	// check on session variables to be sent as ClientInfo
 	if w.sv != nil && !w.svSent && messageType.ClientInfoSupported() {
 		parts = append([]PartEncoder{(*clientInfo)(&w.sv)}, parts...)
@@ -425,30 +513,76 @@ func (w *Writer) _write(ctx context.Context, messageType MessageType, commit boo
 		return fmt.Errorf("message size %d exceeds maximum message header value %d", size, int64(math.MaxUint32)) // int64: without cast overflow error in 32bit OS
 	}
 
-	bufferSize := size
-
 	w.mh.sessionID = w.sessionID
-	w.mh.varPartLength = uint32(size)     //nolint: gosec
-	w.mh.varPartSize = uint32(bufferSize) //nolint: gosec
+	w.mh.varPartLength = uint32(size) //nolint: gosec
+	w.mh.varPartSize = uint32(size)   //nolint: gosec
 	w.mh.noOfSegm = 1
+	w.mh.packetOptions = 0
+	w.mh.compressionVarPartLength = 0
+
+	if w.compression && size >= compressionThreshold {
+		return w.writeCompressed(ctx, messageType, commit, commandOptions, parts, partSize, size)
+	}
+
+	if err := w.writeMessageHeader(ctx); err != nil {
+		return err
+	}
+	if err := w.writeSegment(ctx, messageType, commit, commandOptions, parts, partSize, size); err != nil {
+		return err
+	}
+	return w.wr.Flush()
+}
+
+func (w *Writer) writeCompressed(ctx context.Context, messageType MessageType, commit bool, commandOptions CommandOptions, parts []PartEncoder, partSize []int, size int64) error {
+	w.buf.Reset()
+	wr := w.enc.SetWriter(&w.buf)
+	err := w.writeSegment(ctx, messageType, commit, commandOptions, parts, partSize, size)
+	w.enc.SetWriter(wr)
+	if err != nil {
+		return err
+	}
+
+	data := w.buf.Bytes()
+	w.cbuf = w.compressor.Compress(w.cbuf[:0], data)
+	if len(w.cbuf) < len(data) { // send compressed data only if it is smaller
+		w.mh.packetOptions = poCompressed
+		w.mh.compressionVarPartLength = w.mh.varPartLength
+		w.mh.varPartLength = uint32(len(w.cbuf)) //nolint: gosec
+		w.mh.varPartSize = w.mh.varPartLength
+		data = w.cbuf
+	}
+
+	if err := w.writeMessageHeader(ctx); err != nil {
+		return err
+	}
+	w.enc.Bytes(data)
+	return w.wr.Flush()
+}
 
+func (w *Writer) writeMessageHeader(ctx context.Context) error {
 	if err := w.mh.encode(w.enc); err != nil {
 		return err
 	}
 	if w.protTrace {
 		w.logger.LogAttrs(ctx, slog.LevelInfo, traceMsg, slog.String(prefixClient+textMsgHdr, w.mh.String()))
 	}
+	return nil
+}
 
+func (w *Writer) writeSegment(ctx context.Context, messageType MessageType, commit bool, commandOptions CommandOptions, parts []PartEncoder, partSize []int, size int64) error {
 	if size > math.MaxInt32 {
 		return fmt.Errorf("message size %d exceeds maximum part header value %d", size, math.MaxInt32)
 	}
 
+	bufferSize := size
+
 	w.sh.messageType = messageType
 	w.sh.commit = commit
+	w.sh.commandOptions = commandOptions
 	w.sh.segmentKind = skRequest
 	w.sh.segmentLength = int32(size) //nolint: gosec
 	w.sh.segmentOfs = 0
-	w.sh.noOfParts = int16(numPart) //nolint: gosec
+	w.sh.noOfParts = int16(len(parts)) //nolint: gosec
 	w.sh.segmentNo = 1
 
 	if err := w.sh.encode(w.enc); err != nil {
@@ -489,5 +623,5 @@ func (w *Writer) _write(ctx context.Context, messageType MessageType, commit boo
 
 		bufferSize -= int64(partHeaderSize + size + pad)
 	}
-	return w.wr.Flush()
+	return nil
 }
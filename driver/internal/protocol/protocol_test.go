@@ -0,0 +1,271 @@
+package protocol
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
+	"github.com/SAP/go-hdb/driver/unicode/cesu8"
+)
+
+func testCompression(t *testing.T, compression bool, query string, compressed bool) {
+	ctx := context.Background()
+	buf := new(bytes.Buffer)
+
+	wr := bufio.NewWriter(buf)
+	pwr := NewWriter(wr, encoding.NewEncoder(wr, cesu8.DefaultEncoder()), false, slog.Default(), nil)
+	pwr.SetCompression(compression)
+
+	if err := pwr.Write(ctx, MtExecuteDirect, false, Command(query), Fetchsize(42)); err != nil {
+		t.Fatal(err)
+	}
+
+	mh := &messageHeader{}
+	if err := mh.decode(encoding.NewDecoder(bytes.NewReader(buf.Bytes()), cesu8.DefaultDecoder(), false)); err != nil {
+		t.Fatal(err)
+	}
+	if mh.compressed() != compressed {
+		t.Fatalf("compressed %t - expected %t", mh.compressed(), compressed)
+	}
+
+	prd := NewClientReader(encoding.NewDecoder(buf, cesu8.DefaultDecoder(), false), cesu8.DefaultDecoder(), false, slog.Default(), 0)
+
+	var command Command
+	var fetchsize Fetchsize
+	if _, err := prd.IterateParts(ctx, 0, func(kind PartKind, attrs PartAttributes) error {
+		switch kind {
+		case PkCommand:
+			return prd.ReadPart(ctx, &command, nil)
+		case PkFetchSize:
+			return prd.ReadPart(ctx, &fetchsize, nil)
+		default:
+			return ErrSkipped
+		}
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if string(command) != query {
+		t.Fatalf("command %.20s... - expected %.20s...", command, query)
+	}
+	if fetchsize != 42 {
+		t.Fatalf("fetchsize %d - expected %d", fetchsize, 42)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("%d unread bytes", buf.Len())
+	}
+}
+
+func TestInflateSizeLimit(t *testing.T) {
+	testData := []struct {
+		name                              string
+		varPartLength, uncompressedLength uint32
+	}{
+		{"maxSize", 1 << 31, 1 << 31},
+		{"ratio", 16, 1 << 30},
+	}
+
+	for _, d := range testData {
+		t.Run(d.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			mh := &messageHeader{varPartLength: d.varPartLength, noOfSegm: 1, packetOptions: poCompressed, compressionVarPartLength: d.uncompressedLength}
+			if err := mh.encode(encoding.NewEncoder(buf, cesu8.DefaultEncoder())); err != nil {
+				t.Fatal(err)
+			}
+			prd := NewDBReader(encoding.NewDecoder(buf, cesu8.DefaultDecoder(), false), cesu8.DefaultDecoder(), false, slog.Default(), 0)
+			if _, err := prd.IterateParts(context.Background(), 0, nil); err == nil {
+				t.Fatal("expected message size error")
+			}
+		})
+	}
+}
+
+func TestCompression(t *testing.T) {
+	smallQuery := "select * from dummy"
+	largeQuery := "select * from dummy where " + strings.Repeat("a = 'abcdefgh' and ", 1000) + "1 = 1"
+
+	testData := []struct {
+		name        string
+		compression bool
+		query       string
+		compressed  bool
+	}{
+		{"off", false, largeQuery, false},
+		{"belowThreshold", true, smallQuery, false},
+		{"on", true, largeQuery, true},
+	}
+
+	for _, d := range testData {
+		t.Run(d.name, func(t *testing.T) {
+			testCompression(t, d.compression, d.query, d.compressed)
+		})
+	}
+}
+
+func TestXATransactionInfo(t *testing.T) {
+	xi := &XATransactionInfo{Flags: 0x00800000, XIDs: []*XID{
+		{FormatID: 1, GlobalTransactionID: []byte("gtrid1"), BranchQualifier: []byte("bqual1")},
+		{FormatID: 2, GlobalTransactionID: []byte("gtrid2"), BranchQualifier: []byte{}},
+	}}
+
+	buf := new(bytes.Buffer)
+	if err := xi.encode(encoding.NewEncoder(buf, cesu8.DefaultEncoder())); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != xi.size() {
+		t.Fatalf("encoded size %d - expected %d", buf.Len(), xi.size())
+	}
+
+	decoded := &XATransactionInfo{}
+	if err := decoded.decodeNumArg(encoding.NewDecoder(buf, cesu8.DefaultDecoder(), false), xi.numArg()); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.String() != xi.String() {
+		t.Fatalf("decoded %s - expected %s", decoded, xi)
+	}
+}
+
+func TestTopologyInformation(t *testing.T) {
+	hosts := []options[topologyOption]{
+		{toHostName: "host1", toHostPortnumber: int32(30003), toVolumeID: int32(2), toIsPrimary: true, toIsCurrentSession: true, toServiceType: int32(StIndexServer)},
+		{toHostName: "host2", toHostPortnumber: int32(30040), toVolumeID: int32(3), toServiceType: int32(StIndexServer)},
+		{toHostName: "host3", toHostPortnumber: int32(30003), toVolumeID: int32(2), toIsPrimary: true, toServiceType: int32(StIndexServer), toSiteType: int32(SiteTypeSecondary)},
+	}
+
+	buf := new(bytes.Buffer)
+	enc := encoding.NewEncoder(buf, cesu8.DefaultEncoder())
+	for _, host := range hosts {
+		enc.Int16(int16(len(host)))
+		if err := host.encode(enc); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ti := &TopologyInformation{}
+	if err := ti.decodeNumArg(encoding.NewDecoder(buf, cesu8.DefaultDecoder(), false), len(hosts)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []TopologyHost{
+		{Host: "host1", Port: 30003, VolumeID: 2, IsPrimary: true, IsCurrentSession: true, ServiceType: StIndexServer},
+		{Host: "host2", Port: 30040, VolumeID: 3, ServiceType: StIndexServer},
+		{Host: "host3", Port: 30003, VolumeID: 2, IsPrimary: true, ServiceType: StIndexServer, SiteType: SiteTypeSecondary},
+	}
+	if len(ti.Hosts()) != len(expected) {
+		t.Fatalf("number of hosts %d - expected %d", len(ti.Hosts()), len(expected))
+	}
+	for i, host := range ti.Hosts() {
+		if *host != expected[i] {
+			t.Fatalf("host %d: %v - expected %v", i, *host, expected[i])
+		}
+	}
+}
+
+func TestTableLocation(t *testing.T) {
+	buf := new(bytes.Buffer)
+	enc := encoding.NewEncoder(buf, cesu8.DefaultEncoder())
+	enc.Int32(3)
+	enc.Int32(5)
+
+	var l TableLocation
+	if err := l.decodeNumArg(encoding.NewDecoder(buf, cesu8.DefaultDecoder(), false), 2); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(l, TableLocation{3, 5}) {
+		t.Fatalf("table location %v - expected %v", l, TableLocation{3, 5})
+	}
+}
+
+func TestStatementContext(t *testing.T) {
+	sc := &StatementContext{options: options[statementContextType]{
+		scServerProcessingTime: int64(1500),
+		scServerCPUTime:        int64(700),
+		scServerMemoryUsage:    int64(4096),
+	}}
+
+	buf := new(bytes.Buffer)
+	if err := sc.encode(encoding.NewEncoder(buf, cesu8.DefaultEncoder())); err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := &StatementContext{}
+	if err := decoded.decodeNumArg(encoding.NewDecoder(buf, cesu8.DefaultDecoder(), false), len(sc.options)); err != nil {
+		t.Fatal(err)
+	}
+	if v := decoded.ServerProcessingTimeOrZero(); v != 1500 {
+		t.Fatalf("server processing time %d - expected %d", v, 1500)
+	}
+	if v := decoded.ServerCPUTimeOrZero(); v != 700 {
+		t.Fatalf("server cpu time %d - expected %d", v, 700)
+	}
+	if v := decoded.ServerMemoryUsageOrZero(); v != 4096 {
+		t.Fatalf("server memory usage %d - expected %d", v, 4096)
+	}
+}
+
+func TestXATransactionInfoWire(t *testing.T) {
+	// wire sample: flags (int32 little endian), per XID: format id (int32 little endian),
+	// length indicator prefixed global transaction id and branch qualifier.
+	sample := []byte{
+		0x00, 0x00, 0x00, 0x04, // flags: XASuccess
+		0x01, 0x00, 0x00, 0x00, // format id: 1
+		0x04, 'g', 't', 'r', 'd', // global transaction id
+		0x02, 'b', 'q', // branch qualifier
+	}
+	xi := &XATransactionInfo{Flags: 0x04000000, XIDs: []*XID{{FormatID: 1, GlobalTransactionID: []byte("gtrd"), BranchQualifier: []byte("bq")}}}
+
+	buf := new(bytes.Buffer)
+	if err := xi.encode(encoding.NewEncoder(buf, cesu8.DefaultEncoder())); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), sample) {
+		t.Fatalf("encoded %x - expected %x", buf.Bytes(), sample)
+	}
+
+	decoded := &XATransactionInfo{}
+	if err := decoded.decodeNumArg(encoding.NewDecoder(bytes.NewReader(sample), cesu8.DefaultDecoder(), false), 1); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.String() != xi.String() {
+		t.Fatalf("decoded %s - expected %s", decoded, xi)
+	}
+
+	// recover reply wire sample: two in-doubt transaction branches, the second one without branch qualifier.
+	recoverSample := []byte{
+		0x00, 0x00, 0x00, 0x00, // flags
+		0x01, 0x00, 0x00, 0x00, // format id: 1
+		0x02, 'g', '1', // global transaction id
+		0x02, 'b', '1', // branch qualifier
+		0x02, 0x00, 0x00, 0x00, // format id: 2
+		0x02, 'g', '2', // global transaction id
+		0x00, // empty branch qualifier
+	}
+	recovered := &XATransactionInfo{}
+	if err := recovered.decodeNumArg(encoding.NewDecoder(bytes.NewReader(recoverSample), cesu8.DefaultDecoder(), false), 2); err != nil {
+		t.Fatal(err)
+	}
+	expected := &XATransactionInfo{XIDs: []*XID{
+		{FormatID: 1, GlobalTransactionID: []byte("g1"), BranchQualifier: []byte("b1")},
+		{FormatID: 2, GlobalTransactionID: []byte("g2"), BranchQualifier: []byte{}},
+	}}
+	if recovered.String() != expected.String() {
+		t.Fatalf("decoded %s - expected %s", recovered, expected)
+	}
+}
+
+func TestOptionTypeMismatch(t *testing.T) {
+	co := &ConnectOptions{}
+	co.options.set(coConnectionID, int64(42)) // int32 expected
+	if v := co.ConnectionIDOrZero(); v != 0 {
+		t.Fatalf("connection id %d - expected %d", v, 0)
+	}
+	co.options.set(coDatabaseName, true) // string expected
+	if v := co.DatabaseNameOrZero(); v != "" {
+		t.Fatalf("database name %q - expected %q", v, "")
+	}
+}
@@ -58,3 +58,16 @@ func (id *StatementID) decode(dec *encoding.Decoder) error {
 
 // Encode implements the partEncoder interface.
 func (id StatementID) encode(enc *encoding.Encoder) error { enc.Uint64(uint64(id)); return nil }
+
+// TableLocation represents a table location part.
+// The part contains the volume ids of the database hosts storing the data accessed by a prepared statement.
+type TableLocation []int32
+
+func (l TableLocation) String() string { return fmt.Sprintf("%v", []int32(l)) }
+func (l *TableLocation) decodeNumArg(dec *encoding.Decoder, numArg int) error {
+	*l = resizeSlice(*l, numArg)
+	for i := range numArg {
+		(*l)[i] = dec.Int32()
+	}
+	return dec.Error()
+}
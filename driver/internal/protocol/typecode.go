@@ -72,6 +72,7 @@ const (
 	tcFixed8            typeCode = 0x51
 	tcFixed12           typeCode = 0x52
 	tcCiphertext        typeCode = 0x5A
+	tcRealVector        typeCode = 0x60 // HANA Cloud vector engine
 
 	// special null values.
 	tcSecondtimeNull typeCode = 0xB0
@@ -86,7 +87,7 @@ func (tc typeCode) isLob() bool {
 }
 
 func (tc typeCode) isVariableLength() bool {
-	return tc == tcChar || tc == tcNchar || tc == tcVarchar || tc == tcNvarchar || tc == tcBinary || tc == tcVarbinary || tc == tcShorttext || tc == tcAlphanum
+	return tc == tcChar || tc == tcNchar || tc == tcVarchar || tc == tcNvarchar || tc == tcBinary || tc == tcVarbinary || tc == tcShorttext || tc == tcAlphanum || tc == tcRealVector // real vector: length is the dimension
 }
 
 func (tc typeCode) isDecimalType() bool {
@@ -154,6 +155,10 @@ func (tc typeCode) dataType() DataType {
 		return DtTime
 	case tcTime, tcTimestamp, tcLongdate, tcSeconddate, tcDaydate, tcSecondtime:
 		return DtTime
+	case tcTimetz, tcTimeltz, tcTimestampTz, tcTimestampLtz:
+		return DtTime
+	case tcIntervalYm, tcIntervalDs:
+		return DtInterval
 	case tcDecimal, tcFixed8, tcFixed12, tcFixed16:
 		return DtDecimal
 	case tcChar, tcVarchar, tcString, tcAlphanum, tcNchar, tcNvarchar, tcNstring, tcShorttext, tcStPoint, tcStGeometry:
@@ -162,6 +167,8 @@ func (tc typeCode) dataType() DataType {
 		return DtBytes
 	case tcBlob, tcClob, tcNclob, tcText, tcBintext:
 		return DtLob
+	case tcRealVector:
+		return DtRealVector
 	case TcTableRows:
 		return DtRows
 	default:
@@ -172,5 +179,8 @@ func (tc typeCode) dataType() DataType {
 // typeName returns the database type name.
 // see https://golang.org/pkg/database/sql/driver/#RowsColumnTypeDatabaseTypeName
 func (tc typeCode) typeName() string {
+	if tc == tcRealVector {
+		return "REAL_VECTOR"
+	}
 	return strings.ToUpper(tc.String()[2:])
 }
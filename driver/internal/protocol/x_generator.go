@@ -1,3 +1,3 @@
 package protocol
 
-//go:generate stringer -type=typeCode,MessageType,clientContextOption,connectOption,dbConnectInfoType,DataType,FunctionCode,PartKind,Cdm,endianness,segmentKind,statementContextType,topologyOption,ServiceType,transactionFlagType,dpv,lobTypecode -output=x_stringer.go
+//go:generate stringer -type=typeCode,MessageType,clientContextOption,connectOption,dbConnectInfoType,DataType,FunctionCode,PartKind,Cdm,endianness,segmentKind,statementContextType,topologyOption,ServiceType,transactionFlagType,dpv,lobTypecode,fetchOptionType -output=x_stringer.go
@@ -1,4 +1,4 @@
-// Code generated by "stringer -type=typeCode,MessageType,clientContextOption,connectOption,dbConnectInfoType,DataType,FunctionCode,PartKind,Cdm,endianness,segmentKind,statementContextType,topologyOption,ServiceType,transactionFlagType,dpv,lobTypecode -output=x_stringer.go"; DO NOT EDIT.
+// Code generated by "stringer -type=typeCode,MessageType,clientContextOption,connectOption,dbConnectInfoType,DataType,FunctionCode,PartKind,Cdm,endianness,segmentKind,statementContextType,topologyOption,ServiceType,transactionFlagType,dpv,lobTypecode,fetchOptionType -output=x_stringer.go"; DO NOT EDIT.
 
 package protocol
 
@@ -70,21 +70,23 @@ func _() {
 	_ = x[tcFixed8-81]
 	_ = x[tcFixed12-82]
 	_ = x[tcCiphertext-90]
+	_ = x[tcRealVector-96]
 	_ = x[tcSecondtimeNull-176]
 	_ = x[TcTableRows-127]
 }
 
 const (
-	_typeCode_name_0 = "tcNulltcTinyinttcSmallinttcIntegertcBiginttcDecimaltcRealtcDoubletcChartcVarchartcNchartcNvarchartcBinarytcVarbinarytcDatetcTimetcTimestamptcTimetztcTimeltztcTimestampTztcTimestampLtztcIntervalYmtcIntervalDstcRowidtcUrowidtcClobtcNclobtcBlobtcBooleantcStringtcNstringtcLocatortcNlocatortcBstringtcDecimalDigitArraytcVarchar2"
-	_typeCode_name_1 = "tcTable"
-	_typeCode_name_2 = "tcSmalldecimaltcAbapstreamtcAbapstructtcAarraytcTexttcShorttexttcBintext"
-	_typeCode_name_3 = "tcAlphanum"
-	_typeCode_name_4 = "tcLongdatetcSeconddatetcDaydatetcSecondtime"
-	_typeCode_name_5 = "tcClocatortcBlobDiskReservedtcClobDiskReservedtcNclobDiskReservedtcStGeometrytcStPointtcFixed16tcAbapItabtcRecordRowStoretcRecordColumnStore"
-	_typeCode_name_6 = "tcFixed8tcFixed12"
-	_typeCode_name_7 = "tcCiphertext"
-	_typeCode_name_8 = "TcTableRows"
-	_typeCode_name_9 = "tcSecondtimeNull"
+	_typeCode_name_0  = "tcNulltcTinyinttcSmallinttcIntegertcBiginttcDecimaltcRealtcDoubletcChartcVarchartcNchartcNvarchartcBinarytcVarbinarytcDatetcTimetcTimestamptcTimetztcTimeltztcTimestampTztcTimestampLtztcIntervalYmtcIntervalDstcRowidtcUrowidtcClobtcNclobtcBlobtcBooleantcStringtcNstringtcLocatortcNlocatortcBstringtcDecimalDigitArraytcVarchar2"
+	_typeCode_name_1  = "tcTable"
+	_typeCode_name_2  = "tcSmalldecimaltcAbapstreamtcAbapstructtcAarraytcTexttcShorttexttcBintext"
+	_typeCode_name_3  = "tcAlphanum"
+	_typeCode_name_4  = "tcLongdatetcSeconddatetcDaydatetcSecondtime"
+	_typeCode_name_5  = "tcClocatortcBlobDiskReservedtcClobDiskReservedtcNclobDiskReservedtcStGeometrytcStPointtcFixed16tcAbapItabtcRecordRowStoretcRecordColumnStore"
+	_typeCode_name_6  = "tcFixed8tcFixed12"
+	_typeCode_name_7  = "tcCiphertext"
+	_typeCode_name_8  = "tcRealVector"
+	_typeCode_name_9  = "TcTableRows"
+	_typeCode_name_10 = "tcSecondtimeNull"
 )
 
 var (
@@ -117,10 +119,12 @@ func (i typeCode) String() string {
 		return _typeCode_name_6[_typeCode_index_6[i]:_typeCode_index_6[i+1]]
 	case i == 90:
 		return _typeCode_name_7
-	case i == 127:
+	case i == 96:
 		return _typeCode_name_8
-	case i == 176:
+	case i == 127:
 		return _typeCode_name_9
+	case i == 176:
+		return _typeCode_name_10
 	default:
 		return "typeCode(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
@@ -146,23 +150,23 @@ func _() {
 	_ = x[MtCloseResultset-69]
 	_ = x[MtDropStatementID-70]
 	_ = x[MtFetchNext-71]
-	_ = x[mtFetchAbsolute-72]
-	_ = x[mtFetchRelative-73]
-	_ = x[mtFetchFirst-74]
-	_ = x[mtFetchLast-75]
+	_ = x[MtFetchAbsolute-72]
+	_ = x[MtFetchRelative-73]
+	_ = x[MtFetchFirst-74]
+	_ = x[MtFetchLast-75]
 	_ = x[MtDisconnect-77]
 	_ = x[mtExecuteITab-78]
 	_ = x[mtFetchNextITab-79]
 	_ = x[mtInsertNextITab-80]
 	_ = x[mtBatchPrepare-81]
 	_ = x[MtDBConnectInfo-82]
-	_ = x[mtXopenXAStart-83]
-	_ = x[mtXopenXAEnd-84]
-	_ = x[mtXopenXAPrepare-85]
-	_ = x[mtXopenXACommit-86]
-	_ = x[mtXopenXARollback-87]
-	_ = x[mtXopenXARecover-88]
-	_ = x[mtXopenXAForget-89]
+	_ = x[MtXopenXAStart-83]
+	_ = x[MtXopenXAEnd-84]
+	_ = x[MtXopenXAPrepare-85]
+	_ = x[MtXopenXACommit-86]
+	_ = x[MtXopenXARollback-87]
+	_ = x[MtXopenXARecover-88]
+	_ = x[MtXopenXAForget-89]
 }
 
 const (
@@ -170,8 +174,8 @@ const (
 	_MessageType_name_1 = "MtExecuteDirectMtPreparemtAbapStreammtXAStartmtXAJoin"
 	_MessageType_name_2 = "MtExecute"
 	_MessageType_name_3 = "MtWriteLobMtReadLobmtFindLob"
-	_MessageType_name_4 = "MtAuthenticateMtConnectMtCommitMtRollbackMtCloseResultsetMtDropStatementIDMtFetchNextmtFetchAbsolutemtFetchRelativemtFetchFirstmtFetchLast"
-	_MessageType_name_5 = "MtDisconnectmtExecuteITabmtFetchNextITabmtInsertNextITabmtBatchPrepareMtDBConnectInfomtXopenXAStartmtXopenXAEndmtXopenXAPreparemtXopenXACommitmtXopenXARollbackmtXopenXARecovermtXopenXAForget"
+	_MessageType_name_4 = "MtAuthenticateMtConnectMtCommitMtRollbackMtCloseResultsetMtDropStatementIDMtFetchNextMtFetchAbsoluteMtFetchRelativeMtFetchFirstMtFetchLast"
+	_MessageType_name_5 = "MtDisconnectmtExecuteITabmtFetchNextITabmtInsertNextITabmtBatchPrepareMtDBConnectInfoMtXopenXAStartMtXopenXAEndMtXopenXAPrepareMtXopenXACommitMtXopenXARollbackMtXopenXARecoverMtXopenXAForget"
 )
 
 var (
@@ -341,11 +345,13 @@ func _() {
 	_ = x[DtBytes-11]
 	_ = x[DtLob-12]
 	_ = x[DtRows-13]
+	_ = x[DtRealVector-14]
+	_ = x[DtInterval-15]
 }
 
-const _DataType_name = "DtUnknownDtBooleanDtTinyintDtSmallintDtIntegerDtBigintDtRealDtDoubleDtDecimalDtTimeDtStringDtBytesDtLobDtRows"
+const _DataType_name = "DtUnknownDtBooleanDtTinyintDtSmallintDtIntegerDtBigintDtRealDtDoubleDtDecimalDtTimeDtStringDtBytesDtLobDtRowsDtRealVectorDtInterval"
 
-var _DataType_index = [...]uint8{0, 9, 18, 27, 37, 46, 54, 60, 68, 77, 83, 91, 98, 103, 109}
+var _DataType_index = [...]uint8{0, 9, 18, 27, 37, 46, 54, 60, 68, 77, 83, 91, 98, 103, 109, 121, 131}
 
 func (i DataType) String() string {
 	idx := int(i) - 0
@@ -408,7 +414,7 @@ func _() {
 	_ = x[pkRowsAffected-12]
 	_ = x[PkResultsetID-13]
 	_ = x[PkTopologyInformation-15]
-	_ = x[pkTableLocation-16]
+	_ = x[PkTableLocation-16]
 	_ = x[PkReadLobRequest-17]
 	_ = x[PkReadLobReply-18]
 	_ = x[pkAbapIStream-25]
@@ -427,7 +433,7 @@ func _() {
 	_ = x[PkOutputParameters-41]
 	_ = x[PkConnectOptions-42]
 	_ = x[pkCommitOptions-43]
-	_ = x[pkFetchOptions-44]
+	_ = x[PkFetchOptions-44]
 	_ = x[PkFetchSize-45]
 	_ = x[PkParameterMetadata-47]
 	_ = x[PkResultMetadata-48]
@@ -450,13 +456,13 @@ func _() {
 	_ = x[PkDBConnectInfo-67]
 	_ = x[pkLobFlags-68]
 	_ = x[pkResultsetOptions-69]
-	_ = x[pkXATransactionInfo-70]
+	_ = x[PkXATransactionInfo-70]
 	_ = x[pkSessionVariable-71]
 	_ = x[pkWorkLoadReplayContext-72]
 	_ = x[pkSQLReplyOptions-73]
 }
 
-const _PartKind_name = "pkNilPkCommandPkResultsetpkErrorPkStatementIDpkTransactionIDpkRowsAffectedPkResultsetIDPkTopologyInformationpkTableLocationPkReadLobRequestPkReadLobReplypkAbapIStreampkAbapOStreampkCommandInfoPkWriteLobRequestPkClientContextPkWriteLobReplyPkParametersPkAuthenticationpkSessionContextPkClientIDpkProfilePkStatementContextpkPartitionInformationPkOutputParametersPkConnectOptionspkCommitOptionspkFetchOptionsPkFetchSizePkParameterMetadataPkResultMetadatapkFindLobRequestpkFindLobReplypkItabSHMpkItabChunkMetadatapkItabMetadatapkItabResultChunkPkClientInfopkStreamDatapkOStreamResultpkFDARequestMetadatapkFDAReplyMetadatapkBatchPreparepkBatchExecutePkTransactionFlagspkRowSlotImageParamMetadatapkRowSlotImageResultsetPkDBConnectInfopkLobFlagspkResultsetOptionspkXATransactionInfopkSessionVariablepkWorkLoadReplayContextpkSQLReplyOptions"
+const _PartKind_name = "pkNilPkCommandPkResultsetpkErrorPkStatementIDpkTransactionIDpkRowsAffectedPkResultsetIDPkTopologyInformationPkTableLocationPkReadLobRequestPkReadLobReplypkAbapIStreampkAbapOStreampkCommandInfoPkWriteLobRequestPkClientContextPkWriteLobReplyPkParametersPkAuthenticationpkSessionContextPkClientIDpkProfilePkStatementContextpkPartitionInformationPkOutputParametersPkConnectOptionspkCommitOptionsPkFetchOptionsPkFetchSizePkParameterMetadataPkResultMetadatapkFindLobRequestpkFindLobReplypkItabSHMpkItabChunkMetadatapkItabMetadatapkItabResultChunkPkClientInfopkStreamDatapkOStreamResultpkFDARequestMetadatapkFDAReplyMetadatapkBatchPreparepkBatchExecutePkTransactionFlagspkRowSlotImageParamMetadatapkRowSlotImageResultsetPkDBConnectInfopkLobFlagspkResultsetOptionsPkXATransactionInfopkSessionVariablepkWorkLoadReplayContextpkSQLReplyOptions"
 
 var _PartKind_map = map[PartKind]string{
 	0:  _PartKind_name[0:5],
@@ -741,3 +747,21 @@ func (i lobTypecode) String() string {
 	}
 	return _lobTypecode_name[_lobTypecode_index[idx]:_lobTypecode_index[idx+1]]
 }
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[foResultsetPos-1]
+}
+
+const _fetchOptionType_name = "foResultsetPos"
+
+var _fetchOptionType_index = [...]uint8{0, 14}
+
+func (i fetchOptionType) String() string {
+	idx := int(i) - 1
+	if i < 1 || idx >= len(_fetchOptionType_index)-1 {
+		return "fetchOptionType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _fetchOptionType_name[_fetchOptionType_index[idx]:_fetchOptionType_index[idx+1]]
+}
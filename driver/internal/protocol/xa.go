@@ -0,0 +1,81 @@
+package protocol
+
+import (
+	"fmt"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
+)
+
+// XID represents a X/Open XA transaction branch identifier.
+type XID struct {
+	FormatID            int32
+	GlobalTransactionID []byte
+	BranchQualifier     []byte
+}
+
+func (xid *XID) String() string {
+	return fmt.Sprintf("formatID %d globalTransactionID %x branchQualifier %x", xid.FormatID, xid.GlobalTransactionID, xid.BranchQualifier)
+}
+
+func (xid *XID) size() int {
+	return encoding.IntegerFieldSize + encoding.VarFieldSize(xid.GlobalTransactionID) + encoding.VarFieldSize(xid.BranchQualifier)
+}
+
+func (xid *XID) decode(dec *encoding.Decoder) error {
+	xid.FormatID = dec.Int32()
+	_, xid.GlobalTransactionID = dec.LIBytes()
+	_, xid.BranchQualifier = dec.LIBytes()
+	return dec.Error()
+}
+
+func (xid *XID) encode(enc *encoding.Encoder) error {
+	enc.Int32(xid.FormatID)
+	if err := enc.LIBytes(xid.GlobalTransactionID); err != nil {
+		return err
+	}
+	return enc.LIBytes(xid.BranchQualifier)
+}
+
+// XATransactionInfo represents a XA transaction info part.
+// The part consists of the XA flags followed by the transaction branch identifiers (number of arguments).
+type XATransactionInfo struct {
+	Flags int32
+	XIDs  []*XID
+}
+
+func (xi *XATransactionInfo) String() string {
+	return fmt.Sprintf("flags %x xids %v", xi.Flags, xi.XIDs)
+}
+
+func (xi *XATransactionInfo) numArg() int { return len(xi.XIDs) }
+
+func (xi *XATransactionInfo) size() int {
+	size := encoding.IntegerFieldSize
+	for _, xid := range xi.XIDs {
+		size += xid.size()
+	}
+	return size
+}
+
+func (xi *XATransactionInfo) decodeNumArg(dec *encoding.Decoder, numArg int) error {
+	xi.Flags = dec.Int32()
+	xi.XIDs = resizeSlice(xi.XIDs, numArg)
+	for i := range numArg {
+		xid := &XID{}
+		if err := xid.decode(dec); err != nil {
+			return err
+		}
+		xi.XIDs[i] = xid
+	}
+	return dec.Error()
+}
+
+func (xi *XATransactionInfo) encode(enc *encoding.Encoder) error {
+	enc.Int32(xi.Flags)
+	for _, xid := range xi.XIDs {
+		if err := xid.encode(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
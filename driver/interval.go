@@ -0,0 +1,95 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
+)
+
+const intervalDay = 24 * time.Hour
+
+/*
+An Interval is the driver representation of a database interval field value.
+
+Year-month intervals (INTERVAL YEAR TO MONTH) use the fields Years and Months, day-second intervals
+(INTERVAL DAY TO SECOND) use the fields Days and Duration (hours, minutes and seconds). Scanned values are
+normalized (Months < 12, Duration < 24h, all fields having the same sign).
+*/
+type Interval struct {
+	Years, Months int
+	Days          int
+	Duration      time.Duration
+}
+
+func (i *Interval) fromField(v encoding.Interval) {
+	i.Years, i.Months = int(v.Months/12), int(v.Months%12)
+	i.Days, i.Duration = int(v.Duration/intervalDay), v.Duration%intervalDay
+}
+
+func (i Interval) field() encoding.Interval {
+	return encoding.Interval{
+		Months:   int64(i.Years)*12 + int64(i.Months),
+		Duration: time.Duration(i.Days)*intervalDay + i.Duration,
+	}
+}
+
+// Scan implements the database/sql/Scanner interface.
+func (i *Interval) Scan(src any) error {
+	switch v := src.(type) {
+	case Interval:
+		*i = v
+	case encoding.Interval:
+		i.fromField(v)
+	default:
+		return fmt.Errorf("interval: invalid data type %T", src)
+	}
+	return nil
+}
+
+// Value implements the database/sql/Valuer interface.
+func (i Interval) Value() (driver.Value, error) {
+	return i.field(), nil
+}
+
+// NullInterval represents an Interval that may be null.
+// NullInterval implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullInterval struct {
+	Interval Interval
+	Valid    bool // Valid is true if Interval is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (n *NullInterval) Scan(value any) error {
+	if value == nil {
+		n.Interval, n.Valid = Interval{}, false
+		return nil
+	}
+	if err := n.Interval.Scan(value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n NullInterval) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Interval.field(), nil
+}
+
+// convertIntervals converts interval field values in place to Interval values, so that
+// values scanned into an any destination are of a driver type.
+func convertIntervals(values []driver.Value) {
+	for i, v := range values {
+		if v, ok := v.(encoding.Interval); ok {
+			var interval Interval
+			interval.fromField(v)
+			values[i] = interval
+		}
+	}
+}
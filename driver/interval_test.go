@@ -0,0 +1,57 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+func TestInterval(t *testing.T) {
+	testData := []Interval{
+		{Years: 1, Months: 2},
+		{Years: -2, Months: -11},
+		{Days: 3, Duration: 4*time.Hour + 30*time.Second},
+		{Days: -1, Duration: -time.Minute},
+	}
+
+	for i, d := range testData {
+		v, err := d.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var rv Interval
+		if err := rv.Scan(v); err != nil {
+			t.Fatal(err)
+		}
+		if rv != d {
+			t.Fatalf("%d: interval %v - expected %v", i, rv, d)
+		}
+	}
+
+	// normalization
+	var rv Interval
+	if err := rv.Scan((Interval{Months: 13, Duration: 25 * time.Hour}).field()); err != nil {
+		t.Fatal(err)
+	}
+	if expected := (Interval{Years: 1, Months: 1, Days: 1, Duration: time.Hour}); rv != expected {
+		t.Fatalf("interval %v - expected %v", rv, expected)
+	}
+
+	// result values
+	values := []driver.Value{(Interval{Days: 2}).field(), "text"}
+	convertIntervals(values)
+	if v, ok := values[0].(Interval); !ok || v != (Interval{Days: 2}) {
+		t.Fatalf("value %#v - expected %v", values[0], Interval{Days: 2})
+	}
+	if err := rv.Scan(values[0]); err != nil || rv != (Interval{Days: 2}) {
+		t.Fatalf("interval %v error %v - expected %v", rv, err, Interval{Days: 2})
+	}
+
+	var nv NullInterval
+	if err := nv.Scan(nil); err != nil || nv.Valid {
+		t.Fatalf("null interval %v error %v - expected invalid", nv, err)
+	}
+	if err := nv.Scan("1h"); err == nil {
+		t.Fatal("expected invalid data type error")
+	}
+}
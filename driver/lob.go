@@ -2,10 +2,12 @@ package driver
 
 import (
 	"bytes"
+	"context"
 	"database/sql/driver"
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 
 	p "github.com/SAP/go-hdb/driver/internal/protocol"
@@ -90,6 +92,53 @@ func ScanLobWriter(src any, wr io.Writer) error {
 	return scanLob(src, wr)
 }
 
+/*
+ScanLobFile supports scanning Lob data into a file.
+
+The lob content is written via WriteAt starting at file offset zero and the file is truncated to the size of the
+lob content. As writes do not depend on the file offset, the file might be shared with concurrent readers.
+For the export of large binary lobs please consider enabling pipelined reads (see Connector.SetLobPrefetch).
+*/
+func ScanLobFile(src any, f *os.File) error {
+	if f == nil {
+		return fmt.Errorf("lob scan error: parameter f %T is nil", f)
+	}
+	wr := io.NewOffsetWriter(f, 0)
+	if err := scanLob(src, wr); err != nil {
+		return err
+	}
+	size, err := wr.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	return f.Truncate(size)
+}
+
+/*
+ScanLobReaderFrom supports scanning Lob data into an io.ReaderFrom object (e.g. a network connection or a buffered writer).
+
+The lob content is provided to the ReadFrom method by an io.Reader, so that the destination can consume chunks while
+further chunks are read from the database. ScanLobReaderFrom returns the number of bytes read by the destination.
+*/
+func ScanLobReaderFrom(src any, rf io.ReaderFrom) (int64, error) {
+	if rf == nil {
+		return 0, fmt.Errorf("lob scan error: parameter rf %T is nil", rf)
+	}
+	rd, wr := io.Pipe()
+	errCh := make(chan error, 1)
+	go func() {
+		err := scanLob(src, wr)
+		wr.CloseWithError(err) // no-op if already closed by the lob scanner
+		errCh <- err
+	}()
+	n, err := rf.ReadFrom(rd)
+	rd.CloseWithError(err) // unblock scanner in case the destination stopped reading
+	if scanErr := <-errCh; err == nil {
+		err = scanErr
+	}
+	return n, err
+}
+
 // A Lob is the driver representation of a database large object field.
 // A Lob object uses an io.Reader object as source for writing content to a database lob field.
 // A Lob object uses an io.Writer object as destination for reading content from a database lob field.
@@ -179,3 +228,108 @@ func (n NullLob) Value() (driver.Value, error) {
 	}
 	return n.Lob, nil
 }
+
+var errLobHandleInvalid = errors.New("lob handle: invalid (not scanned or null value)")
+
+/*
+A LobHandle provides random access to a binary database large object field (BLOB).
+
+In contrast to Lob, which reads a lob field sequentially, a LobHandle reads byte ranges at arbitrary offsets via
+positioned lob read requests.
+LobHandle implements the io.Reader, io.ReaderAt and io.Seeker interfaces.
+The lob read requests are sent via the database connection of the result set the LobHandle has been scanned from.
+ReadAt might be called concurrently with each other and with other operations on the connection, as all requests of
+a connection are serialized. Like for other io.Reader and io.Seeker implementations Read and Seek must not be called
+concurrently.
+A LobHandle is valid until the result set it has been scanned from is closed.
+*/
+type LobHandle struct {
+	locator p.LobLocator
+	pos     int64
+}
+
+// check if LobHandle implements the io interfaces.
+var (
+	_ io.ReadSeeker = (*LobHandle)(nil)
+	_ io.ReaderAt   = (*LobHandle)(nil)
+)
+
+// Scan implements the database/sql/Scanner interface.
+func (h *LobHandle) Scan(src any) error {
+	locator, ok := src.(p.LobLocator)
+	if !ok {
+		return fmt.Errorf("lob handle: invalid scan type %T", src)
+	}
+	h.locator, h.pos = locator, 0
+	return nil
+}
+
+// Size returns the size of the lob in bytes.
+func (h *LobHandle) Size() int64 {
+	if h.locator == nil {
+		return 0
+	}
+	return h.locator.Size()
+}
+
+// ReadAt implements the io.ReaderAt interface.
+func (h *LobHandle) ReadAt(b []byte, off int64) (int, error) {
+	return h.ReadAtContext(context.Background(), b, off)
+}
+
+// ReadAtContext is like ReadAt but the lob read requests are canceled if the context is done.
+func (h *LobHandle) ReadAtContext(ctx context.Context, b []byte, off int64) (int, error) {
+	if h.locator == nil {
+		return 0, errLobHandleInvalid
+	}
+	if off >= h.locator.Size() {
+		return 0, io.EOF
+	}
+	return h.locator.ReadAt(ctx, b, off)
+}
+
+// Read implements the io.Reader interface.
+func (h *LobHandle) Read(b []byte) (int, error) {
+	n, err := h.ReadAt(b, h.pos)
+	h.pos += int64(n)
+	return n, err
+}
+
+// Seek implements the io.Seeker interface.
+func (h *LobHandle) Seek(offset int64, whence int) (int64, error) {
+	if h.locator == nil {
+		return 0, errLobHandleInvalid
+	}
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += h.pos
+	case io.SeekEnd:
+		offset += h.locator.Size()
+	default:
+		return 0, fmt.Errorf("lob handle: invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("lob handle: invalid offset %d", offset)
+	}
+	h.pos = offset
+	return offset, nil
+}
+
+// NullLobHandle represents a LobHandle that may be null.
+// NullLobHandle implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullLobHandle struct {
+	LobHandle LobHandle
+	Valid     bool // Valid is true if LobHandle is not NULL
+}
+
+// Scan implements the database/sql/Scanner interface.
+func (n *NullLobHandle) Scan(value any) error {
+	if value == nil {
+		n.LobHandle, n.Valid = LobHandle{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.LobHandle.Scan(value)
+}
@@ -4,11 +4,13 @@ package driver
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"database/sql"
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"sync"
 	"testing"
 
@@ -415,6 +417,135 @@ func testLobAffectedRows(t *testing.T, db *sql.DB) {
 	}
 }
 
+func testLobHandle(t *testing.T, db *sql.DB) {
+	const lobSize = 100000
+
+	table := RandomIdentifier("lobHandle_")
+
+	data := []byte(newRandomDataBytesLob(lobSize))
+
+	if _, err := db.Exec(fmt.Sprintf("create table %s (b blob)", table)); err != nil {
+		t.Fatalf("create table failed: %s", err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?)", table), data); err != nil {
+		t.Fatal(err)
+	}
+
+	// lob locators are valid within a transaction only
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	rows, err := tx.Query(fmt.Sprintf("select * from %s", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		t.Fatal(rows.Err())
+	}
+	var h LobHandle
+	if err := rows.Scan(&h); err != nil {
+		t.Fatal(err)
+	}
+
+	if h.Size() != lobSize {
+		t.Fatalf("lob size %d - expected %d", h.Size(), lobSize)
+	}
+	for _, off := range []int64{0, 1000, 50000, lobSize - 10} {
+		b := make([]byte, 100)
+		n, err := h.ReadAt(b, off)
+		if err != nil && !errors.Is(err, io.EOF) {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(b[:n], data[off:off+int64(n)]) {
+			t.Fatalf("offset %d: lob data mismatch", off)
+		}
+	}
+	if _, err := h.Seek(-100, io.SeekEnd); err != nil {
+		t.Fatal(err)
+	}
+	b, err := io.ReadAll(&h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, data[lobSize-100:]) {
+		t.Fatal("lob data mismatch")
+	}
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	if _, err := h.ReadAtContext(ctx, make([]byte, 100), 0); !errors.Is(err, context.Canceled) {
+		t.Fatalf("error %v - expected %v", err, context.Canceled)
+	}
+}
+
+type fileLob struct{ f *os.File }
+
+func (l fileLob) Scan(src any) error { return ScanLobFile(src, l.f) }
+
+type readerFromLob struct{ buf *bytes.Buffer }
+
+func (l readerFromLob) Scan(src any) error { _, err := ScanLobReaderFrom(src, l.buf); return err }
+
+func testLobPrefetch(t *testing.T, db *sql.DB) {
+	const lobSize = 1000000
+
+	table := RandomIdentifier("lobPrefetch_")
+
+	data := []byte(newRandomDataBytesLob(lobSize))
+
+	if _, err := db.Exec(fmt.Sprintf("create table %s (b blob)", table)); err != nil {
+		t.Fatalf("create table failed: %s", err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?)", table), data); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, lobPrefetch := range []int{0, 4, 16} {
+		t.Run(fmt.Sprintf("prefetch %d", lobPrefetch), func(t *testing.T) {
+			ctr := MT.NewConnector()
+			ctr.SetLobChunkSize(4096) // lots of chunks
+			ctr.SetLobPrefetch(lobPrefetch)
+			db := sql.OpenDB(ctr)
+			defer db.Close()
+
+			// lob streaming is not permitted in auto-commit mode
+			tx, err := db.Begin()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer tx.Rollback() //nolint:errcheck
+
+			f, err := os.CreateTemp(t.TempDir(), "lob")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			if _, err := f.Write(make([]byte, lobSize+100)); err != nil { // file content to be truncated
+				t.Fatal(err)
+			}
+
+			buf := new(bytes.Buffer)
+			if err := tx.QueryRow(fmt.Sprintf("select b, b from %s", table)).Scan(fileLob{f: f}, readerFromLob{buf: buf}); err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := os.ReadFile(f.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(b, data) {
+				t.Fatalf("file lob data mismatch (size %d - expected %d)", len(b), len(data))
+			}
+			if !bytes.Equal(buf.Bytes(), data) {
+				t.Fatalf("reader from lob data mismatch (size %d - expected %d)", buf.Len(), len(data))
+			}
+		})
+	}
+}
+
 func TestLob(t *testing.T) {
 	tests := []struct {
 		name string
@@ -425,6 +556,8 @@ func TestLob(t *testing.T) {
 		{"delayedScan", testLobDelayedScan},
 		{"nilPlusBigLob", testLobNilPlusBig},
 		{"affectedRows", testLobAffectedRows},
+		{"handle", testLobHandle},
+		{"prefetch", testLobPrefetch},
 	}
 
 	db := MT.DB()
@@ -0,0 +1,100 @@
+package driver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+type lobPipelineRequest struct {
+	ofs  int64
+	size int
+}
+
+// scriptedLobServer answers pipelined lob read requests in request order.
+type scriptedLobServer struct {
+	data      []byte
+	shortRead map[int64]int   // number of bytes returned for the request at offset (short read)
+	errReply  map[int64]error // error returned for the request at offset
+	buf       bytes.Buffer    // lob data written by the replies
+
+	inFlight    []lobPipelineRequest
+	maxInFlight int
+	numSkipped  int
+}
+
+func (s *scriptedLobServer) pipeline() *lobPipeline {
+	return &lobPipeline{
+		writeRequest: func(ofs int64, size int) error {
+			s.inFlight = append(s.inFlight, lobPipelineRequest{ofs: ofs, size: size})
+			s.maxInFlight = max(s.maxInFlight, len(s.inFlight))
+			return nil
+		},
+		readReply: func() (int, error) {
+			r := s.inFlight[0]
+			s.inFlight = s.inFlight[1:]
+			if err, ok := s.errReply[r.ofs]; ok {
+				return 0, err
+			}
+			n := r.size
+			if sr, ok := s.shortRead[r.ofs]; ok {
+				n = sr
+			}
+			s.buf.Write(s.data[r.ofs : r.ofs+int64(n)])
+			if r.ofs+int64(n) == int64(len(s.data)) {
+				return n, io.EOF // last data
+			}
+			return n, nil
+		},
+		skipReply: func() error {
+			s.inFlight = s.inFlight[1:]
+			s.numSkipped++
+			return nil
+		},
+	}
+}
+
+func TestLobPipeline(t *testing.T) {
+	const (
+		chunkSize = 3
+		depth     = 3
+	)
+	data := []byte("0123456789")
+	errReply := errors.New("reply error")
+
+	tests := []struct {
+		name       string
+		shortRead  map[int64]int
+		errReply   map[int64]error
+		err        error
+		numSkipped int
+	}{
+		{"complete", nil, nil, nil, 0},
+		{"shortRead", map[int64]int{3: 1}, nil, nil, 2},
+		{"emptyRead", map[int64]int{3: 0}, nil, io.ErrUnexpectedEOF, 2},
+		{"errorReply", nil, map[int64]error{3: errReply}, errReply, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &scriptedLobServer{data: data, shortRead: test.shortRead, errReply: test.errReply}
+			err := s.pipeline().read(0, int64(len(data)), chunkSize, depth)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("error %v - expected %v", err, test.err)
+			}
+			if len(s.inFlight) != 0 { // replies of all requests need to be read to keep the connection usable
+				t.Fatalf("requests in flight %d - expected 0", len(s.inFlight))
+			}
+			if s.numSkipped != test.numSkipped {
+				t.Fatalf("skipped replies %d - expected %d", s.numSkipped, test.numSkipped)
+			}
+			if s.maxInFlight != depth {
+				t.Fatalf("maximum requests in flight %d - expected %d", s.maxInFlight, depth)
+			}
+			if test.err == nil && !bytes.Equal(s.buf.Bytes(), data) {
+				t.Fatalf("data %s - expected %s", s.buf.Bytes(), data)
+			}
+		})
+	}
+}
@@ -10,6 +10,9 @@ const (
 	counterBytesRead = iota
 	counterBytesWritten
 	counterSessionConnects
+	counterSecondaryTx
+	counterSecondaryFallbacks
+	counterLobBytesRead
 	numCounter
 )
 
@@ -24,9 +27,16 @@ const (
 	timeRead = iota
 	timeWrite
 	timeAuth
+	timeServerProcessing
+	timeServerCPU
 	numTime
 )
 
+const (
+	memoryServerUsage = iota
+	numMemory
+)
+
 const (
 	sqlTimeQuery = iota
 	sqlTimePrepare
@@ -97,6 +107,11 @@ type sqlTimeMsg struct {
 	idx int
 }
 
+type memoryMsg struct {
+	v   int64 // bytes
+	idx int
+}
+
 const numMetricCollectorCh = 100
 
 type metrics struct {
@@ -111,27 +126,38 @@ type metrics struct {
 	timeUnit string
 	divider  float64
 
+	memoryUnit    string
+	memoryDivider float64
+
 	counters []uint64
 	gauges   []int64
 	times    []*histogram
 	sqlTimes []*histogram
+	memories []*histogram
 }
 
-func newMetrics(parentMetrics *metrics, timeUnit string, timeUpperBounds []float64) *metrics {
+func newMetrics(parentMetrics *metrics, timeUnit string, timeUpperBounds []float64, memoryUnit string, memoryUpperBounds []float64) *metrics {
 	d, ok := timeUnitMap[timeUnit]
 	if !ok {
 		panic("invalid unit")
 	}
+	md, ok := memoryUnitMap[memoryUnit]
+	if !ok {
+		panic("invalid memory unit")
+	}
 	rv := &metrics{
 		wg:            new(sync.WaitGroup),
 		msgCh:         make(chan any, numMetricCollectorCh),
 		parentMetrics: parentMetrics,
 		timeUnit:      timeUnit,
 		divider:       float64(d),
+		memoryUnit:    memoryUnit,
+		memoryDivider: float64(md),
 		counters:      make([]uint64, numCounter),
 		gauges:        make([]int64, numGauge),
 		times:         make([]*histogram, numTime),
 		sqlTimes:      make([]*histogram, numSQLTime),
+		memories:      make([]*histogram, numMemory),
 	}
 	for i := range int(numTime) {
 		rv.times[i] = newHistogram(timeUpperBounds)
@@ -139,6 +165,9 @@ func newMetrics(parentMetrics *metrics, timeUnit string, timeUpperBounds []float
 	for i := range int(numSQLTime) {
 		rv.sqlTimes[i] = newHistogram(timeUpperBounds)
 	}
+	for i := range int(numMemory) {
+		rv.memories[i] = newHistogram(memoryUpperBounds)
+	}
 	return rv
 }
 
@@ -199,6 +228,15 @@ func (m *metrics) stats() *Stats {
 		WriteTime:        m.times[timeWrite].stats(),
 		AuthTime:         m.times[timeAuth].stats(),
 		SQLTimes:         sqlTimes,
+
+		ServerProcessingTime: m.times[timeServerProcessing].stats(),
+		ServerCPUTime:        m.times[timeServerCPU].stats(),
+		MemoryUnit:           m.memoryUnit,
+		ServerMemoryUsage:    m.memories[memoryServerUsage].stats(),
+
+		SecondaryTransactions: m.counters[counterSecondaryTx],
+		SecondaryFallbacks:    m.counters[counterSecondaryFallbacks],
+		LobReadBytes:          m.counters[counterLobBytesRead],
 	}
 }
 
@@ -213,6 +251,8 @@ func (m *metrics) handleMsg(msg any) {
 		m.times[msg.idx].add(float64(msg.d.Nanoseconds()) / m.divider)
 	case sqlTimeMsg:
 		m.sqlTimes[msg.idx].add(float64(msg.d.Nanoseconds()) / m.divider)
+	case memoryMsg:
+		m.memories[msg.idx].add(float64(msg.v) / m.memoryDivider)
 	default:
 		panic("invalid metric message type")
 	}
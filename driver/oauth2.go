@@ -0,0 +1,159 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Token is an OAuth2 access token (JWT) used for JWT authentication.
+// A zero Expiry time means that the token does not expire.
+type Token struct {
+	AccessToken string
+	Expiry      time.Time
+}
+
+/*
+TokenSource is the interface of an OAuth2 access token source.
+
+The interface corresponds to the golang.org/x/oauth2 TokenSource interface extended by a context parameter,
+so that token sources of the oauth2 package can be used via a small adapter.
+*/
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// tokenSourceProvider adapts a TokenSource to the CredentialProvider interface.
+type tokenSourceProvider struct {
+	ts TokenSource
+}
+
+func (p *tokenSourceProvider) Credentials(ctx context.Context) (*Credentials, error) {
+	token, err := p.ts.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Credentials{Token: token.AccessToken, Expiry: token.Expiry}, nil
+}
+
+// invalidate drops the cached token of the token source, so that e.g. a token rejected by the database server
+// is not returned again.
+func (p *tokenSourceProvider) invalidate() {
+	if inv, ok := p.ts.(invalidator); ok {
+		inv.invalidate()
+	}
+}
+
+// clientCredentialsTokenSource implements the OAuth2 client credentials grant (RFC 6749 section 4.4).
+type clientCredentialsTokenSource struct {
+	client       *http.Client
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	scopes       []string
+
+	mu    sync.Mutex
+	token *Token
+}
+
+/*
+NewClientCredentialsTokenSource returns a TokenSource fetching access tokens from the token endpoint tokenURL
+via the OAuth2 client credentials grant. Tokens are cached and fetched again before they expire.
+*/
+func NewClientCredentialsTokenSource(tokenURL, clientID, clientSecret string, scopes ...string) TokenSource {
+	return &clientCredentialsTokenSource{
+		client:       http.DefaultClient,
+		tokenURL:     tokenURL,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		scopes:       scopes,
+	}
+}
+
+// tokenResponse is the successful token endpoint response (RFC 6749 section 5.1).
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// tokenErrorResponse is the token endpoint error response (RFC 6749 section 5.2).
+type tokenErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Token implements the TokenSource interface.
+func (s *clientCredentialsTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && !expires(s.token.Expiry, time.Now()) {
+		return s.token, nil
+	}
+	token, err := s.fetchToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.token = token
+	return token, nil
+}
+
+func (s *clientCredentialsTokenSource) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = nil
+}
+
+func (s *clientCredentialsTokenSource) fetchToken(ctx context.Context) (*Token, error) {
+	values := url.Values{"grant_type": {"client_credentials"}}
+	if len(s.scopes) != 0 {
+		values.Set("scope", strings.Join(s.scopes, " "))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(s.clientID), url.QueryEscape(s.clientSecret))
+
+	now := time.Now()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var errResp tokenErrorResponse
+		if json.Unmarshal(body, &errResp) == nil && errResp.Error != "" {
+			return nil, fmt.Errorf("oauth2 token endpoint error %s: %s", errResp.Error, errResp.ErrorDescription)
+		}
+		return nil, fmt.Errorf("oauth2 token endpoint status %s", resp.Status)
+	}
+
+	var tokenResp tokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return nil, fmt.Errorf("invalid oauth2 token endpoint response: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return nil, errors.New("oauth2 token endpoint did not return an access token")
+	}
+	token := &Token{AccessToken: tokenResp.AccessToken}
+	if tokenResp.ExpiresIn > 0 {
+		token.Expiry = now.Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
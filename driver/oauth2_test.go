@@ -0,0 +1,146 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+	"github.com/SAP/go-hdb/driver/internal/protocol/auth"
+)
+
+func TestClientCredentialsTokenSource(t *testing.T) {
+	const (
+		clientID     = "client"
+		clientSecret = "secret"
+	)
+
+	requests := 0
+	expiresIn := 3600
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != clientID || secret != clientSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client", "error_description": "bad credentials"}) //nolint:errcheck
+			return
+		}
+		if r.Method != http.MethodPost || r.FormValue("grant_type") != "client_credentials" || r.FormValue("scope") != "hana" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_request"}) //nolint:errcheck
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"access_token": "eyToken" + strings.Repeat("x", requests), "token_type": "bearer", "expires_in": expiresIn}) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	t.Run("cache", func(t *testing.T) {
+		requests = 0
+		ts := NewClientCredentialsTokenSource(server.URL, clientID, clientSecret, "hana")
+		for range 3 {
+			token, err := ts.Token(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if token.AccessToken != "eyTokenx" {
+				t.Fatalf("token %s - expected %s", token.AccessToken, "eyTokenx")
+			}
+			if time.Until(token.Expiry) < 59*time.Minute {
+				t.Fatalf("token expiry %s - expected about one hour", token.Expiry)
+			}
+		}
+		if requests != 1 {
+			t.Fatalf("requests %d - expected 1", requests)
+		}
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		requests, expiresIn = 0, 1 // token expires within the expiry delta
+		defer func() { expiresIn = 3600 }()
+		ts := NewClientCredentialsTokenSource(server.URL, clientID, clientSecret, "hana")
+		for range 2 {
+			if _, err := ts.Token(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if requests != 2 {
+			t.Fatalf("requests %d - expected 2", requests)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		ts := NewClientCredentialsTokenSource(server.URL, clientID, "invalid", "hana")
+		_, err := ts.Token(ctx)
+		if err == nil || !strings.Contains(err.Error(), "invalid_client") {
+			t.Fatalf("error %v - expected invalid_client error", err)
+		}
+	})
+
+	t.Run("connector", func(t *testing.T) {
+		requests = 0
+		c := NewJWTAuthConnector("host:30015", "")
+		c.SetTokenSource(NewClientCredentialsTokenSource(server.URL, clientID, clientSecret, "hana"))
+		if c.TokenSource() == nil {
+			t.Fatal("token source not set")
+		}
+		if _, err := c.updateCredentials(ctx, false); err != nil {
+			t.Fatal(err)
+		}
+		if c.Token() != "eyTokenx" {
+			t.Fatalf("token %s - expected %s", c.Token(), "eyTokenx")
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		requests = 0
+		c := NewJWTAuthConnector("host:30015", "")
+		c.SetTokenSource(NewClientCredentialsTokenSource(server.URL, clientID, clientSecret, "hana"))
+		if _, err := c.updateCredentials(ctx, false); err != nil {
+			t.Fatal(err)
+		}
+		// first token is rejected by the database server: refresh needs to fetch a new token
+		refreshed, err := c.refresh(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !refreshed || c.Token() != "eyTokenxx" {
+			t.Fatalf("refreshed %t token %s - expected refreshed token %s", refreshed, c.Token(), "eyTokenxx")
+		}
+		if requests != 2 {
+			t.Fatalf("requests %d - expected 2", requests)
+		}
+	})
+
+	t.Run("alwaysRejected", func(t *testing.T) {
+		requests = 0
+		c := NewJWTAuthConnector("host:30015", "")
+		c.SetTokenSource(NewClientCredentialsTokenSource(server.URL, clientID, clientSecret, "hana"))
+		if _, err := c.updateCredentials(ctx, false); err != nil {
+			t.Fatal(err)
+		}
+		// every token is rejected by the database server: credentials are refreshed once per connect only
+		authErr := &auth.CertValidationError{}
+		connects := 0
+		_, err := c.authConnect(ctx, func(authHnd *p.AuthHnd) (*conn, error) {
+			connects++
+			return nil, authErr
+		})
+		if !errors.Is(err, authErr) {
+			t.Fatal("authentication error expected")
+		}
+		if connects != 2 {
+			t.Fatalf("connects %d - expected 2", connects)
+		}
+		if requests != 2 {
+			t.Fatalf("requests %d - expected 2", requests)
+		}
+	})
+}
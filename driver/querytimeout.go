@@ -0,0 +1,45 @@
+package driver
+
+import (
+	"context"
+	"time"
+)
+
+// use unexported type to avoid key collisions.
+type queryTimeoutCtxKeyType struct{}
+
+var queryTimeoutCtxKey queryTimeoutCtxKeyType
+
+/*
+WithQueryTimeout can be used to set a server-side query timeout for Query and Exec calls.
+
+The query timeout is sent to the database server which aborts the statement and returns an error.
+If no query timeout is set, the query timeout is derived from the context deadline, if any. An explicit
+query timeout takes precedence over the deadline and can be used to let the server abort the statement
+before the deadline expires on client side. The query timeout is only applied if it is supported by the
+database server.
+
+If a context deadline expires on client side, the request gets cancelled as described in
+Connector.SetCancelSession.
+*/
+func WithQueryTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, queryTimeoutCtxKey, timeout)
+}
+
+// queryTimeout returns the query timeout set via WithQueryTimeout or the remaining time
+// until the context deadline.
+func queryTimeout(ctx context.Context) (time.Duration, bool) {
+	timeout, ok := ctx.Value(queryTimeoutCtxKey).(time.Duration)
+	if !ok {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return 0, false
+		}
+		timeout = time.Until(deadline)
+	}
+	if timeout <= 0 {
+		return 0, false
+	}
+	// round up to milliseconds as a zero timeout would disable the timeout on server side.
+	return (timeout + time.Millisecond - 1).Truncate(time.Millisecond), true
+}
@@ -0,0 +1,41 @@
+package driver
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestQueryTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	if _, ok := queryTimeout(ctx); ok {
+		t.Fatal("query timeout set - expected none")
+	}
+
+	testData := []struct {
+		timeout  time.Duration
+		expected time.Duration
+	}{
+		{5 * time.Second, 5 * time.Second},
+		{time.Microsecond, time.Millisecond},
+		{1500 * time.Microsecond, 2 * time.Millisecond},
+	}
+	for _, d := range testData {
+		timeout, ok := queryTimeout(WithQueryTimeout(ctx, d.timeout))
+		if !ok || timeout != d.expected {
+			t.Fatalf("query timeout %s %t - expected %s", timeout, ok, d.expected)
+		}
+	}
+
+	deadlineCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	// query timeout is derived from the deadline
+	if timeout, ok := queryTimeout(deadlineCtx); !ok || timeout <= 0 || timeout > time.Minute {
+		t.Fatalf("query timeout %s %t - expected value in (0, %s]", timeout, ok, time.Minute)
+	}
+	// explicit query timeout takes precedence
+	if timeout, _ := queryTimeout(WithQueryTimeout(deadlineCtx, time.Second)); timeout != time.Second {
+		t.Fatalf("query timeout %s - expected %s", timeout, time.Second)
+	}
+}
@@ -0,0 +1,27 @@
+package driver
+
+import (
+	"database/sql/driver"
+)
+
+// NullRealVector represents a REAL_VECTOR ([]float32) that may be null.
+// NullRealVector implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullRealVector struct {
+	RealVector []float32
+	Valid      bool // Valid is true if RealVector is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (n *NullRealVector) Scan(value any) error {
+	n.RealVector, n.Valid = value.([]float32)
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n NullRealVector) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.RealVector, nil
+}
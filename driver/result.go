@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql/driver"
 	"errors"
+	"fmt"
 	"io"
 	"reflect"
 
@@ -19,6 +20,7 @@ var (
 	_ driver.RowsColumnTypeNullable         = (*queryResult)(nil)
 	_ driver.RowsColumnTypePrecisionScale   = (*queryResult)(nil)
 	_ driver.RowsColumnTypeScanType         = (*queryResult)(nil)
+	_ ScrollableRows                        = (*queryResult)(nil)
 
 	// queryMultiResult.
 	_ driver.Rows                           = (*queryMultiResult)(nil)
@@ -33,6 +35,10 @@ var (
 	_ driver.Rows = (*noResultType)(nil)
 	// callResult.
 	_ driver.Rows = (*callResult)(nil)
+
+	// lob random access.
+	_ p.LobLocatorReader = (*queryResult)(nil)
+	_ p.LobLocatorReader = (*callResult)(nil)
 )
 
 type prepareResult struct {
@@ -40,6 +46,7 @@ type prepareResult struct {
 	stmtID          uint64
 	parameterFields []*p.ParameterField
 	resultFields    []*p.ResultField
+	locations       []int32 // volume ids of the table locations (statement routing)
 }
 
 // ParameterTypes implements the PrepareMetadata interface.
@@ -107,6 +114,7 @@ type queryResult struct {
 	pos          int
 	attrs        p.PartAttributes
 	closed       bool
+	scrollable   bool
 }
 
 // ErrScanOnClosedResultset is the error raised in case a scan is executed on a closed resultset.
@@ -165,7 +173,61 @@ func (qr *queryResult) Next(dest []driver.Value) error {
 	copy(dest, qr.fieldValues[qr.pos*cols:(qr.pos+1)*cols])
 	err := qr.decodeErrors.RowErrors(qr.pos)
 	qr.pos++
-	return err
+	if err != nil {
+		return err
+	}
+	convertIntervals(dest[:cols])
+	return nil
+}
+
+func (qr *queryResult) scroll(messageType p.MessageType, pos, fetchSize int) error {
+	if !qr.scrollable {
+		return ErrNotScrollable
+	}
+	if qr.closed {
+		return ErrScanOnClosedResultset
+	}
+	if err := qr.session.fetchScroll(context.Background(), qr, messageType, pos, fetchSize); err != nil {
+		qr.lastErr = err // fieldValues and attrs are nil
+		return err
+	}
+	qr.pos = 0
+	return nil
+}
+
+// First implements the ScrollableRows interface.
+func (qr *queryResult) First() error {
+	return qr.scroll(p.MtFetchFirst, 0, qr.session.attrs.fetchSize)
+}
+
+// Last implements the ScrollableRows interface.
+func (qr *queryResult) Last() error {
+	return qr.scroll(p.MtFetchLast, 0, 1)
+}
+
+// Absolute implements the ScrollableRows interface.
+func (qr *queryResult) Absolute(row int) error {
+	if row == 0 {
+		return fmt.Errorf("invalid absolute row position %d", row)
+	}
+	return qr.scroll(p.MtFetchAbsolute, row, qr.session.attrs.fetchSize)
+}
+
+// Relative implements the ScrollableRows interface.
+func (qr *queryResult) Relative(rows int) error {
+	if !qr.scrollable {
+		return ErrNotScrollable
+	}
+	if qr.closed {
+		return ErrScanOnClosedResultset
+	}
+	// position inside of already fetched rows
+	if pos := qr.pos + rows; pos >= 0 && pos < qr.numRow() {
+		qr.pos = pos
+		return nil
+	}
+	// the server side cursor is positioned on the last fetched row
+	return qr.scroll(p.MtFetchRelative, qr.pos+rows-qr.numRow()+1, qr.session.attrs.fetchSize)
 }
 
 // ColumnTypeDatabaseTypeName implements the driver.RowsColumnTypeDatabaseTypeName interface.
@@ -195,6 +257,14 @@ func (qr *queryResult) ReadLob(request *p.ReadLobRequest, reply *p.ReadLobReply)
 	return qr.session.readLob(context.Background(), request, reply)
 }
 
+// ReadLobChunk used by protocol LobLocatorReader.
+func (qr *queryResult) ReadLobChunk(ctx context.Context, request *p.ReadLobRequest, reply *p.ReadLobReply) error {
+	if qr.closed {
+		return ErrScanOnClosedResultset
+	}
+	return qr.session.readLobChunk(ctx, request, reply)
+}
+
 // queryMultiResult represents multi resultsets of a query.
 type queryMultiResult struct {
 	idx int
@@ -283,7 +353,11 @@ func (cr *callResult) Next(dest []driver.Value) error {
 
 	cr.eof = true
 	copy(dest, cr.fieldValues)
-	return cr.decodeErrors.RowErrors(0)
+	if err := cr.decodeErrors.RowErrors(0); err != nil {
+		return err
+	}
+	convertIntervals(dest)
+	return nil
 }
 
 // Close implements the driver.Rows interface.
@@ -296,3 +370,11 @@ func (cr *callResult) ReadLob(request *p.ReadLobRequest, reply *p.ReadLobReply)
 	}
 	return cr.session.readLob(context.Background(), request, reply)
 }
+
+// ReadLobChunk used by protocol LobLocatorReader.
+func (cr *callResult) ReadLobChunk(ctx context.Context, request *p.ReadLobRequest, reply *p.ReadLobReply) error {
+	if cr.closed {
+		return ErrScanOnClosedResultset
+	}
+	return cr.session.readLobChunk(ctx, request, reply)
+}
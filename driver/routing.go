@@ -0,0 +1,94 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"strconv"
+	"sync"
+)
+
+/*
+router implements client-side statement routing.
+
+The anchor session is the session of the connection. Routed sessions are opened on demand to the
+database hosts reported in the topology information of the anchor session and are identified by
+the volume id of the host.
+*/
+type router struct {
+	logger  *slog.Logger
+	metrics *metrics
+	attrs   *connAttrs
+	anchor  *session
+
+	mu       sync.Mutex
+	sessions map[int]*session // routed sessions by volume id
+}
+
+func newRouter(logger *slog.Logger, metrics *metrics, attrs *connAttrs, anchor *session) *router {
+	return &router{logger: logger, metrics: metrics, attrs: attrs, anchor: anchor, sessions: map[int]*session{}}
+}
+
+/*
+session returns the session a statement with table locations should be executed on.
+
+The anchor session is returned if
+  - no table location is available,
+  - the data is located at the host of the anchor session,
+  - the host is not part of the topology or
+  - a connection to the host cannot be established.
+*/
+func (r *router) session(ctx context.Context, locations []int32) *session {
+	if len(locations) == 0 {
+		return r.anchor
+	}
+	if volumeID, ok := r.anchor.volumeID(); ok {
+		for _, location := range locations {
+			if int(location) == volumeID {
+				return r.anchor
+			}
+		}
+	}
+	volumeID := int(locations[0])
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if session, ok := r.sessions[volumeID]; ok {
+		if !session.isBad() {
+			return session
+		}
+		session.close()
+		delete(r.sessions, volumeID)
+	}
+
+	host, ok := r.anchor.topologyHost(volumeID)
+	if !ok {
+		return r.anchor
+	}
+	addr := net.JoinHostPort(host.Host, strconv.Itoa(host.Port))
+
+	attrs := *r.attrs
+	attrs.anchorConnectionID = r.anchor.connectionID
+
+	session, err := newSession(ctx, addr, r.logger.With(slog.Int("volumeID", volumeID)), r.metrics, &attrs, attrs.authHndFn())
+	if err != nil {
+		r.logger.LogAttrs(ctx, slog.LevelWarn, "statement routing: cannot connect to host - using anchor connection", slog.String("host", addr), slog.Any("error", err))
+		return r.anchor
+	}
+	r.sessions[volumeID] = session
+	return session
+}
+
+// close closes all routed sessions.
+func (r *router) close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	errs := make([]error, 0, len(r.sessions))
+	for volumeID, session := range r.sessions {
+		errs = append(errs, session.close())
+		delete(r.sessions, volumeID)
+	}
+	return errors.Join(errs...)
+}
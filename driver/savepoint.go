@@ -0,0 +1,178 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrNotInTransaction is the error raised if a savepoint operation is executed outside of a transaction.
+var ErrNotInTransaction = errors.New("savepoints are only supported within a transaction")
+
+// UnknownSavepointError is the error raised if a savepoint operation refers to a savepoint which was not set in the current transaction.
+type UnknownSavepointError struct {
+	name string
+}
+
+func (e *UnknownSavepointError) Error() string { return fmt.Sprintf("unknown savepoint %s", e.name) }
+
+// Name returns the name of the savepoint.
+func (e *UnknownSavepointError) Name() string { return e.name }
+
+// savepoint statements.
+const (
+	savepointQuery           = "savepoint %s"
+	rollbackToSavepointQuery = "rollback to savepoint %s"
+	releaseSavepointQuery    = "release savepoint %s"
+)
+
+/*
+SavepointConn enhances a connection with savepoint functions.
+
+Savepoints can only be set within a transaction and are released implicitly when the transaction is committed or rolled back.
+The functions can be used via sql.Conn.Raw or, for a sql.Tx, via the package functions Savepoint, RollbackToSavepoint and ReleaseSavepoint.
+*/
+type SavepointConn interface {
+	// Savepoint sets a savepoint with name name in the current transaction.
+	Savepoint(ctx context.Context, name string) error
+	// RollbackToSavepoint rolls back the current transaction to the savepoint with name name.
+	// Savepoints set after the savepoint are released, whereas the savepoint itself is kept.
+	RollbackToSavepoint(ctx context.Context, name string) error
+	// ReleaseSavepoint releases the savepoint with name name and all savepoints set after it.
+	ReleaseSavepoint(ctx context.Context, name string) error
+}
+
+var _ SavepointConn = (*conn)(nil)
+
+// savepoint operations.
+const (
+	spoSet = iota
+	spoRollbackTo
+	spoRelease
+)
+
+// savepointOp is the savepoint operation executed via a sql.Tx.
+type savepointOp struct {
+	op   int
+	name string
+}
+
+// use unexported type to avoid key collisions.
+type savepointCtxKeyType struct{}
+
+var savepointCtxKey savepointCtxKeyType
+
+func execSavepointOp(ctx context.Context, tx *sql.Tx, op int, name string) error {
+	// database/sql does not provide access to the driver connection of a transaction - so the
+	// savepoint operation is passed to the driver connection via context.
+	_, err := tx.ExecContext(context.WithValue(ctx, savepointCtxKey, &savepointOp{op: op, name: name}), "")
+	return err
+}
+
+// Savepoint sets a savepoint with name name in transaction tx.
+func Savepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	return execSavepointOp(ctx, tx, spoSet, name)
+}
+
+// RollbackToSavepoint rolls back transaction tx to the savepoint with name name.
+func RollbackToSavepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	return execSavepointOp(ctx, tx, spoRollbackTo, name)
+}
+
+// ReleaseSavepoint releases the savepoint with name name in transaction tx.
+func ReleaseSavepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	return execSavepointOp(ctx, tx, spoRelease, name)
+}
+
+func (c *conn) execSavepointOp(ctx context.Context, spo *savepointOp) error {
+	switch spo.op {
+	case spoSet:
+		return c.Savepoint(ctx, spo.name)
+	case spoRollbackTo:
+		return c.RollbackToSavepoint(ctx, spo.name)
+	case spoRelease:
+		return c.ReleaseSavepoint(ctx, spo.name)
+	default:
+		panic("invalid savepoint operation") // should never happen
+	}
+}
+
+func (c *conn) checkSavepoint(session *session, name string) (int, error) {
+	if !session.inTx.Load() {
+		return -1, ErrNotInTransaction
+	}
+	if name == "" {
+		return -1, errors.New("invalid savepoint name: empty")
+	}
+	return slices.Index(session.savepoints, name), nil
+}
+
+func (c *conn) savepoint(ctx context.Context, session *session, query, name string) error {
+	var sqlErr error
+	done := make(chan struct{})
+	c.wg.Go(func() {
+		defer close(done)
+		_, sqlErr = session.execDirect(ctx, fmt.Sprintf(query, Identifier(name)))
+	})
+
+	select {
+	case <-ctx.Done():
+		session.cancelStmt(done)
+		return ctx.Err()
+	case <-done:
+		return sqlErr
+	}
+}
+
+// Savepoint implements the SavepointConn interface.
+func (c *conn) Savepoint(ctx context.Context, name string) error {
+	session := c.txSession()
+	idx, err := c.checkSavepoint(session, name)
+	if err != nil {
+		return err
+	}
+	if idx != -1 {
+		return fmt.Errorf("savepoint %s already exists", name)
+	}
+	if err := c.savepoint(ctx, session, savepointQuery, name); err != nil {
+		return err
+	}
+	session.savepoints = append(session.savepoints, name)
+	return nil
+}
+
+// RollbackToSavepoint implements the SavepointConn interface.
+func (c *conn) RollbackToSavepoint(ctx context.Context, name string) error {
+	session := c.txSession()
+	idx, err := c.checkSavepoint(session, name)
+	if err != nil {
+		return err
+	}
+	if idx == -1 {
+		return &UnknownSavepointError{name: name}
+	}
+	if err := c.savepoint(ctx, session, rollbackToSavepointQuery, name); err != nil {
+		return err
+	}
+	session.savepoints = session.savepoints[:idx+1]
+	return nil
+}
+
+// ReleaseSavepoint implements the SavepointConn interface.
+func (c *conn) ReleaseSavepoint(ctx context.Context, name string) error {
+	session := c.txSession()
+	idx, err := c.checkSavepoint(session, name)
+	if err != nil {
+		return err
+	}
+	if idx == -1 {
+		return &UnknownSavepointError{name: name}
+	}
+	if err := c.savepoint(ctx, session, releaseSavepointQuery, name); err != nil {
+		return err
+	}
+	session.savepoints = session.savepoints[:idx]
+	return nil
+}
@@ -0,0 +1,66 @@
+package driver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+// ErrNotScrollable is the error raised if a scroll operation is executed on a resultset which was not opened with a scrollable cursor.
+var ErrNotScrollable = errors.New("resultset is not scrollable - please use WithScrollableCursor")
+
+// ScrollableRows extends driver.Rows with go-hdb specific scrollable cursor functions.
+// The driver rows of a query implement ScrollableRows if the query was executed with a context
+// created by WithScrollableCursor.
+type ScrollableRows interface {
+	driver.Rows
+	// First positions the cursor so that the subsequent call of Next returns the first row.
+	First() error
+	// Last positions the cursor so that the subsequent call of Next returns the last row.
+	Last() error
+	// Absolute positions the cursor so that the subsequent call of Next returns the row with number row (starting with 1).
+	// Negative values are counting from the end of the resultset (-1 is the last row).
+	Absolute(row int) error
+	// Relative moves the cursor by rows rows relative to the row the subsequent call of Next would return.
+	// Negative values are moving the cursor backwards, Relative(0) does not change the cursor position.
+	Relative(rows int) error
+}
+
+// use unexported type to avoid key collisions.
+type scrollableCursorCtxKeyType struct{}
+
+var scrollableCursorCtxKey scrollableCursorCtxKeyType
+
+/*
+WithScrollableCursor can be used to execute a query with a scrollable cursor.
+
+As database/sql does not provide access to the driver rows, the query needs to be executed on the
+driver connection (see sql.Conn.Raw) to use the ScrollableRows functions:
+
+	err := conn.Raw(func(driverConn any) error {
+		rows, err := driverConn.(driver.QueryerContext).QueryContext(driver.WithScrollableCursor(ctx), query, nil)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		scrollableRows := rows.(driver.ScrollableRows)
+		...
+	})
+*/
+func WithScrollableCursor(ctx context.Context) context.Context {
+	return context.WithValue(ctx, scrollableCursorCtxKey, true)
+}
+
+func scrollableCursor(ctx context.Context) bool {
+	scrollable, _ := ctx.Value(scrollableCursorCtxKey).(bool)
+	return scrollable
+}
+
+func queryCommandOptions(scrollable bool) p.CommandOptions {
+	if scrollable {
+		return p.CoScrollableCursorOn
+	}
+	return 0
+}
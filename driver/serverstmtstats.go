@@ -0,0 +1,44 @@
+package driver
+
+import (
+	"context"
+	"time"
+)
+
+/*
+ServerStmtStats provides the statement statistics reported by the database server.
+
+Values not reported by the database server are zero. In case a statement execution needs more than one
+database server roundtrip (e.g. bulk executions) the times are summed up and the memory usage is the
+maximum memory usage of all roundtrips.
+*/
+type ServerStmtStats struct {
+	ProcessingTime time.Duration // Server processing time.
+	CPUTime        time.Duration // Server CPU time.
+	MemoryUsage    int64         // Server memory usage in bytes.
+}
+
+func (s *ServerStmtStats) reset() { *s = ServerStmtStats{} }
+
+func (s *ServerStmtStats) add(processingTime, cpuTime time.Duration, memoryUsage int64) {
+	s.ProcessingTime += processingTime
+	s.CPUTime += cpuTime
+	s.MemoryUsage = max(s.MemoryUsage, memoryUsage)
+}
+
+// use unexported type to avoid key collisions.
+type serverStmtStatsCtxKeyType struct{}
+
+var serverStmtStatsCtxKey serverStmtStatsCtxKeyType
+
+// WithServerStmtStats can be used to add a server statement statistics reference to the context used for a Query or Exec call.
+// The Query or Exec call will set the server statement statistics reported by the database server.
+func WithServerStmtStats(ctx context.Context, stats *ServerStmtStats) context.Context {
+	return context.WithValue(ctx, serverStmtStatsCtxKey, stats)
+}
+
+func resetServerStmtStats(ctx context.Context) {
+	if stats, ok := ctx.Value(serverStmtStatsCtxKey).(*ServerStmtStats); ok {
+		stats.reset()
+	}
+}
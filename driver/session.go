@@ -48,7 +48,9 @@ func WithUserSwitch(ctx context.Context, u *SessionUser) context.Context {
 }
 
 type session struct {
+	host    string
 	dbConn  dbConn
+	logger  *slog.Logger
 	metrics *metrics
 	attrs   *connAttrs
 
@@ -60,8 +62,23 @@ type session struct {
 
 	user *SessionUser // session user
 
+	queryTimeoutSupported bool
+	activeActive          bool // Active/Active protocol supported by the database server (read-only routing)
+
+	connectionID int
+	topology     []*p.TopologyHost // topology information reported by the server (statement routing)
+
 	// atomic as data race got reported on closeTx + exec in parallel
-	inTx atomic.Bool
+	inTx       atomic.Bool
+	savepoints []string // savepoints of the current transaction
+
+	/*
+		reqMu serializes the request/reply round trips of the session.
+		database/sql serializes the calls of a connection, but lob requests (LobHandle, lob scanning) are issued by the
+		application outside of database/sql and might run concurrently to other operations of the connection.
+		In contrast to sync.Mutex the lock can be canceled by a context (see lockContext).
+	*/
+	reqMu reqMutex
 
 	sqlTracer *sqlTracer
 
@@ -104,7 +121,7 @@ func newSession(ctx context.Context, host string, logger *slog.Logger, metrics *
 	if sqlTrace.Load() {
 		sqlTracer = newSQLTracer(logger, 0)
 	}
-	s := &session{dbConn: dbConn, metrics: metrics, attrs: attrs, prd: prd, pwr: pwr, sqlTracer: sqlTracer}
+	s := &session{host: host, dbConn: dbConn, logger: logger, metrics: metrics, attrs: attrs, prd: prd, pwr: pwr, reqMu: newReqMutex(), sqlTracer: sqlTracer}
 
 	if authHnd != nil { // authenticate
 		serverOptions, err := s.authenticate(ctx, authHnd, attrs)
@@ -125,11 +142,122 @@ func newSession(ctx context.Context, host string, logger *slog.Logger, metrics *
 	return s, nil
 }
 
+// reqMutex is a mutex which lock operation can be canceled by a context.
+type reqMutex chan struct{}
+
+func newReqMutex() reqMutex { return make(reqMutex, 1) }
+
+func (m reqMutex) lock()   { m <- struct{}{} }
+func (m reqMutex) unlock() { <-m }
+
+// lockContext locks the mutex or returns the context error if the context is done before the lock is acquired.
+func (m reqMutex) lockContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case m <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // we cannot work with nested errors containing driver.ErrBadConn
 // as go sql retries these statements.
 func (s *session) isBad() bool { return s.canceled || s.pwr.HasError() }
 func (s *session) cancel()     { s.canceled = true }
 
+// cancelTimeout is the time budget for canceling a statement on the database server and for reading
+// the statement reply after the context of the statement execution is done.
+const cancelTimeout = 2 * time.Second
+
+// cancelSessionQuery cancels the running statement of a database session.
+const cancelSessionQuery = "alter system cancel session '%d'"
+
+/*
+cancelStmt is called if the context of a statement execution is done while the execution
+(signaled via done) is still running.
+
+If non-destructive cancellation is enabled the statement is canceled on the database server and
+the reply of the statement is read, so that the session can be reused. Otherwise or in case of errors
+the session is marked as bad. cancelStmt returns true if the statement execution is finished, so that the
+caller can release resources (statements, resultsets) created by the execution. In any case the caller
+returns the context error.
+
+As the cancellation of a statement might roll back the open transaction on the database server, the session is
+marked as bad as well if the statement is canceled within a transaction, so that the transaction cannot be committed.
+*/
+func (s *session) cancelStmt(done <-chan struct{}) bool {
+	select {
+	case <-done: // execution finished concurrently: nothing to cancel.
+		if s.inTx.Load() {
+			s.cancel() // the caller reports the statement as canceled: the transaction must not be committed.
+		}
+		return true
+	default:
+	}
+	if !s.attrs.cancelSession || s.connectionID == 0 || s.attrs.authHndFn == nil {
+		s.cancel()
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cancelTimeout)
+	defer cancel()
+
+	if err := s.cancelServerStmt(ctx); err != nil {
+		s.logger.LogAttrs(ctx, slog.LevelWarn, "statement cancellation failed - invalidating connection", slog.Any("error", err))
+		s.cancel()
+		return false
+	}
+
+	select {
+	case <-done: // reply read: session can be reused.
+		if s.inTx.Load() {
+			s.cancel() // transaction might be rolled back.
+		}
+		return true
+	case <-ctx.Done():
+		s.cancel()
+		return false
+	}
+}
+
+// cancelServerStmt cancels the running statement of the session via an additional session.
+func (s *session) cancelServerStmt(ctx context.Context) error {
+	attrs := *s.attrs
+	attrs.statementRouting = false
+
+	cs, err := newSession(ctx, s.host, s.logger, s.metrics, &attrs, attrs.authHndFn())
+	if err != nil {
+		return err
+	}
+	defer cs.close()
+
+	_, err = cs.execDirect(ctx, fmt.Sprintf(cancelSessionQuery, s.connectionID))
+	return err
+}
+
+// volumeID returns the volume id of the database host the session is connected to.
+func (s *session) volumeID() (int, bool) {
+	for _, host := range s.topology {
+		if host.IsCurrentSession {
+			return host.VolumeID, true
+		}
+	}
+	return 0, false
+}
+
+// topologyHost returns the topology information of the database host with volume id volumeID.
+func (s *session) topologyHost(volumeID int) (*p.TopologyHost, bool) {
+	for _, host := range s.topology {
+		if host.VolumeID == volumeID {
+			return host, true
+		}
+	}
+	return nil, false
+}
+
 func (s *session) close() error {
 	// do not disconnect if isBad.
 	var disconnectErr error
@@ -177,8 +305,14 @@ func (s *session) authenticate(ctx context.Context, authHnd *p.AuthHnd, attrs *c
 
 	co := &p.ConnectOptions{}
 	co.SetDataFormatVersion2(attrs.dfv)
-	co.SetClientDistributionMode(p.CdmOff)
-	// co.SetClientDistributionMode(p.CdmConnectionStatement)
+	if attrs.statementRouting {
+		co.SetClientDistributionMode(p.CdmConnectionStatement)
+		if attrs.anchorConnectionID != 0 {
+			co.SetOriginalAnchorConnectionID(attrs.anchorConnectionID)
+		}
+	} else {
+		co.SetClientDistributionMode(p.CdmOff)
+	}
 	// co.SetSelectForUpdateSupported(true) // doesn't seem to make a difference
 	/*
 		p.CoSplitBatchCommands:          true,
@@ -188,6 +322,22 @@ func (s *session) authenticate(ctx context.Context, authHnd *p.AuthHnd, attrs *c
 	if attrs.locale != "" {
 		co.SetClientLocale(attrs.locale)
 	}
+	if attrs.compression {
+		co.SetCompression(true)
+	}
+	if attrs.xa {
+		co.SetXOpenXAProtocolSupported(true)
+	}
+	// the query timeout option announces a client capability only (no session settings are changed): a server not
+	// supporting query timeouts does not return the option, so that statement context query timeouts are never sent.
+	co.SetQueryTimeoutSupported(true)
+	if attrs.readOnlyRouting {
+		co.SetActiveActiveProtocolVersion(activeActiveProtocolVersion)
+		if attrs.associatedConnID != 0 {
+			co.SetAssociatedConnectionID(attrs.associatedConnID)
+			co.SetActiveActiveConnectionOriginSite(p.SiteTypePrimary)
+		}
+	}
 
 	if err := s.pwr.Write(ctx, p.MtConnect, false, finalRequest, p.ClientID(clientID), co); err != nil {
 		return nil, err
@@ -220,6 +370,12 @@ func (s *session) authenticate(ctx context.Context, authHnd *p.AuthHnd, attrs *c
 		return nil, fmt.Errorf("invalid session id %d", sessionID)
 	}
 	s.pwr.SetSessionID(sessionID)
+	s.connectionID = co.ConnectionIDOrZero()
+	s.queryTimeoutSupported = co.QueryTimeoutSupportedOrZero()
+	s.activeActive = co.ActiveActiveProtocolVersionOrZero() > 0
+	s.topology = ti.Hosts()
+	// compress messages only if the server did accept compression - otherwise fall back to uncompressed messages
+	s.pwr.SetCompression(attrs.compression && co.CompressionOrZero())
 	return co, nil
 }
 
@@ -268,7 +424,50 @@ func (s *session) preventSwitchUser(ctx context.Context) error {
 	return ErrSwitchUser
 }
 
+// stmtParts returns the statement execution parts extended by a statement context part
+// in case a query timeout is set and supported by the database server.
+func (s *session) stmtParts(ctx context.Context, parts ...p.PartEncoder) []p.PartEncoder {
+	if !s.queryTimeoutSupported {
+		return parts
+	}
+	timeout, ok := queryTimeout(ctx)
+	if !ok {
+		return parts
+	}
+	sc := &p.StatementContext{}
+	sc.SetQueryTimeout(timeout.Milliseconds())
+	return append(parts, sc)
+}
+
+// addServerStmtStats adds the server statement context values of the last reply to the metrics
+// and to the server statement statistics of the context.
+func (s *session) addServerStmtStats(ctx context.Context) {
+	sc, ok := s.prd.StatementContext()
+	if !ok {
+		return
+	}
+	processingTime := time.Duration(sc.ServerProcessingTimeOrZero()) * time.Microsecond
+	cpuTime := time.Duration(sc.ServerCPUTimeOrZero()) * time.Microsecond
+	memoryUsage := sc.ServerMemoryUsageOrZero()
+
+	if processingTime != 0 {
+		s.metrics.msgCh <- timeMsg{idx: timeServerProcessing, d: processingTime}
+	}
+	if cpuTime != 0 {
+		s.metrics.msgCh <- timeMsg{idx: timeServerCPU, d: cpuTime}
+	}
+	if memoryUsage != 0 {
+		s.metrics.msgCh <- memoryMsg{idx: memoryServerUsage, v: memoryUsage}
+	}
+	if stats, ok := ctx.Value(serverStmtStatsCtxKey).(*ServerStmtStats); ok {
+		stats.add(processingTime, cpuTime, memoryUsage)
+	}
+}
+
 func (s *session) dbConnectInfo(ctx context.Context, databaseName string) (*DBConnectInfo, error) {
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
 	ci := &p.DBConnectInfo{}
 	ci.SetDatabaseName(databaseName)
 	if err := s.pwr.Write(ctx, p.MtDBConnectInfo, false, ci); err != nil {
@@ -296,8 +495,13 @@ func (s *session) queryDirect(ctx context.Context, query string, traceKind strin
 	t := time.Now()
 	defer metricsAddSQLTimeValue(s.metrics, time.Now(), sqlTimeQuery)
 
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
+	scrollable := scrollableCursor(ctx)
+
 	// allow e.g inserts as query -> handle commit like in _execDirect
-	if err := s.pwr.Write(ctx, p.MtExecuteDirect, !s.inTx.Load(), p.Command(query)); err != nil {
+	if err := s.pwr.WriteWithCommandOptions(ctx, p.MtExecuteDirect, !s.inTx.Load(), queryCommandOptions(scrollable), s.stmtParts(ctx, p.Command(query))...); err != nil {
 		return nil, err
 	}
 
@@ -309,7 +513,7 @@ func (s *session) queryDirect(ctx context.Context, query string, traceKind strin
 	if _, err := s.prd.IterateParts(ctx, 0, func(kind p.PartKind, attrs p.PartAttributes) error {
 		switch kind {
 		case p.PkResultMetadata:
-			qr = &queryResult{session: s}
+			qr = &queryResult{session: s, scrollable: scrollable}
 			qrs = append(qrs, qr)
 			if err := s.prd.ReadPart(ctx, meta, nil); err != nil {
 				return err
@@ -333,6 +537,7 @@ func (s *session) queryDirect(ctx context.Context, query string, traceKind strin
 	}); err != nil {
 		return nil, err
 	}
+	s.addServerStmtStats(ctx)
 	if s.sqlTracer != nil {
 		s.sqlTracer.log(ctx, t, traceKind, query)
 	}
@@ -351,7 +556,10 @@ func (s *session) execDirectQueryLog(ctx context.Context, query, logQuery string
 	t := time.Now()
 	defer metricsAddSQLTimeValue(s.metrics, time.Now(), sqlTimeExec)
 
-	if err := s.pwr.Write(ctx, p.MtExecuteDirect, !s.inTx.Load(), p.Command(query)); err != nil {
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
+	if err := s.pwr.Write(ctx, p.MtExecuteDirect, !s.inTx.Load(), s.stmtParts(ctx, p.Command(query))...); err != nil {
 		return nil, err
 	}
 
@@ -359,6 +567,7 @@ func (s *session) execDirectQueryLog(ctx context.Context, query, logQuery string
 	if err != nil {
 		return nil, err
 	}
+	s.addServerStmtStats(ctx)
 	if s.sqlTracer != nil {
 		s.sqlTracer.log(ctx, t, traceExec, logQuery)
 	}
@@ -376,6 +585,9 @@ func (s *session) prepare(ctx context.Context, query string) (*prepareResult, er
 	t := time.Now()
 	defer metricsAddSQLTimeValue(s.metrics, time.Now(), sqlTimePrepare)
 
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
 	if err := s.pwr.Write(ctx, p.MtPrepare, false, p.Command(query)); err != nil {
 		return nil, err
 	}
@@ -400,6 +612,8 @@ func (s *session) prepare(ctx context.Context, query string) (*prepareResult, er
 			}
 			pr.parameterFields = prmMeta.ParameterFields
 			return nil
+		case p.PkTableLocation:
+			return s.prd.ReadPart(ctx, (*p.TableLocation)(&pr.locations), nil)
 		default:
 			return p.ErrSkipped
 		}
@@ -417,6 +631,9 @@ func (s *session) query(ctx context.Context, query string, pr *prepareResult, nv
 	t := time.Now()
 	defer metricsAddSQLTimeValue(s.metrics, time.Now(), sqlTimeQuery)
 
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
 	// allow e.g inserts as query -> handle commit like in exec
 
 	if err := convertQueryArgs(pr.parameterFields, nvargs, s.attrs.cesu8Encoder, s.attrs.lobChunkSize); err != nil {
@@ -426,11 +643,13 @@ func (s *session) query(ctx context.Context, query string, pr *prepareResult, nv
 	if err != nil {
 		return nil, err
 	}
-	if err := s.pwr.Write(ctx, p.MtExecute, !s.inTx.Load(), p.StatementID(pr.stmtID), inputParameters); err != nil {
+	scrollable := scrollableCursor(ctx)
+
+	if err := s.pwr.WriteWithCommandOptions(ctx, p.MtExecute, !s.inTx.Load(), queryCommandOptions(scrollable), s.stmtParts(ctx, p.StatementID(pr.stmtID), inputParameters)...); err != nil {
 		return nil, err
 	}
 
-	qr := &queryResult{session: s, fields: pr.resultFields}
+	qr := &queryResult{session: s, fields: pr.resultFields, scrollable: scrollable}
 	resSet := &p.Resultset{}
 
 	if _, err := s.prd.IterateParts(ctx, 0, func(kind p.PartKind, attrs p.PartAttributes) error {
@@ -452,6 +671,7 @@ func (s *session) query(ctx context.Context, query string, pr *prepareResult, nv
 	}); err != nil {
 		return nil, err
 	}
+	s.addServerStmtStats(ctx)
 	if s.sqlTracer != nil {
 		s.sqlTracer.log(ctx, t, traceQuery, query, nvargs...)
 	}
@@ -469,7 +689,10 @@ func (s *session) exec(ctx context.Context, query string, pr *prepareResult, nva
 	if err != nil {
 		return nil, err
 	}
-	if err := s.pwr.Write(ctx, p.MtExecute, !s.inTx.Load(), p.StatementID(pr.stmtID), inputParameters); err != nil {
+	// lob data is written after the lock is released as lob readers might issue requests on this session
+	s.reqMu.lock()
+	if err := s.pwr.Write(ctx, p.MtExecute, !s.inTx.Load(), s.stmtParts(ctx, p.StatementID(pr.stmtID), inputParameters)...); err != nil {
+		s.reqMu.unlock()
 		return nil, err
 	}
 
@@ -489,9 +712,12 @@ func (s *session) exec(ctx context.Context, query string, pr *prepareResult, nva
 		}
 	})
 	if err != nil {
+		s.reqMu.unlock()
 		return nil, err
 	}
+	s.addServerStmtStats(ctx)
 	fc := s.prd.FunctionCode()
+	s.reqMu.unlock()
 
 	if len(ids) != 0 {
 		/*
@@ -535,7 +761,10 @@ func (s *session) execCall(ctx context.Context, query string, pr *prepareResult,
 		return nil, nil, 0, err
 	}
 
-	if err := s.pwr.Write(ctx, p.MtExecute, !s.inTx.Load(), (*p.StatementID)(&pr.stmtID), inputParameters); err != nil {
+	// lob data is written after the lock is released as lob readers might issue requests on this session
+	s.reqMu.lock()
+	if err := s.pwr.Write(ctx, p.MtExecute, !s.inTx.Load(), s.stmtParts(ctx, (*p.StatementID)(&pr.stmtID), inputParameters)...); err != nil {
+		s.reqMu.unlock()
 		return nil, nil, 0, err
 	}
 
@@ -598,8 +827,11 @@ func (s *session) execCall(ctx context.Context, query string, pr *prepareResult,
 		}
 	})
 	if err != nil {
+		s.reqMu.unlock()
 		return nil, nil, 0, err
 	}
+	s.addServerStmtStats(ctx)
+	s.reqMu.unlock()
 
 	if len(ids) != 0 {
 		/*
@@ -625,10 +857,35 @@ func (s *session) execCall(ctx context.Context, query string, pr *prepareResult,
 func (s *session) fetchNext(ctx context.Context, qr *queryResult) error {
 	defer metricsAddSQLTimeValue(s.metrics, time.Now(), sqlTimeFetch)
 
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
 	if err := s.pwr.Write(ctx, p.MtFetchNext, false, p.ResultsetID(qr.rsID), p.Fetchsize(s.attrs.fetchSize)); err != nil { //nolint: gosec
 		return err
 	}
+	return s.readResultset(ctx, qr)
+}
+
+// fetchScroll fetches rows of a scrollable cursor (fetch first, last, absolute or relative).
+func (s *session) fetchScroll(ctx context.Context, qr *queryResult, messageType p.MessageType, pos, fetchSize int) error {
+	defer metricsAddSQLTimeValue(s.metrics, time.Now(), sqlTimeFetch)
+
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
+	parts := []p.PartEncoder{p.ResultsetID(qr.rsID), p.Fetchsize(fetchSize)}
+	if messageType == p.MtFetchAbsolute || messageType == p.MtFetchRelative {
+		fetchOptions := &p.FetchOptions{}
+		fetchOptions.SetResultsetPos(pos)
+		parts = append(parts, fetchOptions)
+	}
+	if err := s.pwr.Write(ctx, messageType, false, parts...); err != nil {
+		return err
+	}
+	return s.readResultset(ctx, qr)
+}
 
+func (s *session) readResultset(ctx context.Context, qr *queryResult) error {
 	resSet := &p.Resultset{ResultFields: qr.fields, FieldValues: qr.fieldValues} // reuse field values
 
 	_, err := s.prd.IterateParts(ctx, 0, func(kind p.PartKind, attrs p.PartAttributes) error {
@@ -649,6 +906,9 @@ func (s *session) fetchNext(ctx context.Context, qr *queryResult) error {
 }
 
 func (s *session) dropStatementID(ctx context.Context, id uint64) error {
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
 	if err := s.pwr.Write(ctx, p.MtDropStatementID, false, p.StatementID(id)); err != nil {
 		return err
 	}
@@ -656,6 +916,9 @@ func (s *session) dropStatementID(ctx context.Context, id uint64) error {
 }
 
 func (s *session) closeResultsetID(ctx context.Context, id uint64) error {
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
 	if err := s.pwr.Write(ctx, p.MtCloseResultset, false, p.ResultsetID(id)); err != nil {
 		return err
 	}
@@ -665,6 +928,9 @@ func (s *session) closeResultsetID(ctx context.Context, id uint64) error {
 func (s *session) commit(ctx context.Context) error {
 	defer metricsAddSQLTimeValue(s.metrics, time.Now(), sqlTimeCommit)
 
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
 	if err := s.pwr.Write(ctx, p.MtCommit, false); err != nil {
 		return err
 	}
@@ -677,6 +943,9 @@ func (s *session) commit(ctx context.Context) error {
 func (s *session) rollback(ctx context.Context) error {
 	defer metricsAddSQLTimeValue(s.metrics, time.Now(), sqlTimeRollback)
 
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
 	if err := s.pwr.Write(ctx, p.MtRollback, false); err != nil {
 		return err
 	}
@@ -686,7 +955,47 @@ func (s *session) rollback(ctx context.Context) error {
 	return nil
 }
 
+func (s *session) xa(ctx context.Context, messageType p.MessageType, flags int32, xid *p.XID) error {
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
+	switch messageType {
+	case p.MtXopenXACommit:
+		defer metricsAddSQLTimeValue(s.metrics, time.Now(), sqlTimeCommit)
+	case p.MtXopenXARollback:
+		defer metricsAddSQLTimeValue(s.metrics, time.Now(), sqlTimeRollback)
+	}
+
+	if err := s.pwr.Write(ctx, messageType, false, &p.XATransactionInfo{Flags: flags, XIDs: []*p.XID{xid}}); err != nil {
+		return err
+	}
+	return s.prd.SkipParts(ctx)
+}
+
+func (s *session) xaRecover(ctx context.Context, flags int32) ([]*p.XID, error) {
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
+	if err := s.pwr.Write(ctx, p.MtXopenXARecover, false, &p.XATransactionInfo{Flags: flags}); err != nil {
+		return nil, err
+	}
+
+	xi := &p.XATransactionInfo{}
+	if _, err := s.prd.IterateParts(ctx, 0, func(kind p.PartKind, attrs p.PartAttributes) error {
+		if kind == p.PkXATransactionInfo {
+			return s.prd.ReadPart(ctx, xi, nil)
+		}
+		return p.ErrSkipped
+	}); err != nil {
+		return nil, err
+	}
+	return xi.XIDs, nil
+}
+
 func (s *session) disconnect(ctx context.Context) error {
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
 	if err := s.pwr.Write(ctx, p.MtDisconnect, false); err != nil {
 		return err
 	}
@@ -712,29 +1021,157 @@ read lob reply
 func (s *session) readLob(ctx context.Context, request *p.ReadLobRequest, reply *p.ReadLobReply) error {
 	defer metricsAddSQLTimeValue(s.metrics, time.Now(), sqlTimeFetchLob)
 
-	var err error
-	for err != io.EOF { //nolint: errorlint
-		if err = s.pwr.Write(ctx, p.MtWriteLob, false, request); err != nil {
-			return err
-		}
+	s.reqMu.lock()
+	defer s.reqMu.unlock()
+
+	var numByte uint64
+	defer func() { s.metrics.msgCh <- counterMsg{idx: counterLobBytesRead, v: numByte} }()
 
-		if _, err = s.prd.IterateParts(ctx, 0, func(kind p.PartKind, attrs p.PartAttributes) error {
+	readReply := func() (int, error) {
+		if _, err := s.prd.IterateParts(ctx, 0, func(kind p.PartKind, attrs p.PartAttributes) error {
 			if kind == p.PkReadLobReply {
 				return s.prd.ReadPart(ctx, reply, nil)
 			}
 			return p.ErrSkipped
 		}); err != nil {
-			return err
+			return 0, err
 		}
+		numByte += uint64(reply.NumByte())
+		return reply.Write()
+	}
+
+	if s.attrs.lobPrefetch > 1 && !reply.IsCharBased() {
+		lp := &lobPipeline{
+			writeRequest: func(ofs int64, size int) error {
+				return s.pwr.Write(ctx, p.MtWriteLob, false, request.Chunk(ofs, size))
+			},
+			readReply: readReply,
+			skipReply: func() error { return s.prd.SkipParts(ctx) },
+		}
+		return lp.read(request.Offset(), reply.Size(), int64(request.ChunkSize()), s.attrs.lobPrefetch)
+	}
 
-		_, err = reply.Write()
-		if err != nil && err != io.EOF { //nolint: errorlint
+	var err error
+	for err != io.EOF { //nolint: errorlint
+		if err = s.pwr.Write(ctx, p.MtWriteLob, false, request); err != nil {
+			return err
+		}
+		if _, err = readReply(); err != nil && err != io.EOF { //nolint: errorlint
 			return err
 		}
 	}
 	return nil
 }
 
+// lobPipeline provides the protocol operations of a pipelined lob read.
+type lobPipeline struct {
+	writeRequest func(ofs int64, size int) error // sends a read request for size bytes at offset ofs
+	readReply    func() (int, error)             // reads the next reply and writes its data (io.EOF: last data)
+	skipReply    func() error                    // reads and discards the next reply
+}
+
+/*
+read reads a binary lob of size bytes starting at offset ofs keeping up to depth read requests in flight.
+
+  - the requests are answered by the database server in order, so the chunks can be written in request order
+  - in case the server returns less data than requested (short read) the pending replies are discarded and
+    reading is continued at the offset following the received data
+  - in case of an error the replies of the pending requests are discarded, so that the connection stays usable
+*/
+func (lp *lobPipeline) read(ofs, size, chunkSize int64, depth int) error {
+	pending := make([]int64, 0, depth) // offsets of requests in flight
+	skipReplies := func() error {
+		for range pending {
+			if err := lp.skipReply(); err != nil {
+				return err
+			}
+		}
+		pending = pending[:0]
+		return nil
+	}
+
+	next := ofs
+	for {
+		for len(pending) < depth && next < size {
+			if err := lp.writeRequest(next, int(min(chunkSize, size-next))); err != nil {
+				return err
+			}
+			pending = append(pending, next)
+			next += chunkSize
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		ofs := pending[0]
+		pending = pending[1:]
+
+		n, err := lp.readReply()
+		if err == io.EOF { //nolint: errorlint
+			return skipReplies()
+		}
+		if err != nil {
+			skipReplies() //nolint: errcheck // report the reply error
+			return err
+		}
+		if int64(n) < min(chunkSize, size-ofs) { // short read
+			if n == 0 {
+				skipReplies() //nolint: errcheck // report the short read
+				return io.ErrUnexpectedEOF
+			}
+			if err := skipReplies(); err != nil {
+				return err
+			}
+			next = ofs + int64(n)
+		}
+	}
+}
+
+/*
+readLobChunk reads a single lob chunk at the request offset (random access).
+
+As readLobChunk is called by the application outside of database/sql, the request is canceled here the same way the
+connection cancels statements: waiting for the request lock and the running request are aborted if the context is done.
+*/
+func (s *session) readLobChunk(ctx context.Context, request *p.ReadLobRequest, reply *p.ReadLobReply) error {
+	defer metricsAddSQLTimeValue(s.metrics, time.Now(), sqlTimeFetchLob)
+
+	if err := s.reqMu.lockContext(ctx); err != nil {
+		return err
+	}
+
+	var sqlErr error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer s.reqMu.unlock() // unlock after the reply is read (or the session got invalidated by the cancellation)
+		sqlErr = s.readLobChunkReq(ctx, request, reply)
+	}()
+
+	select {
+	case <-ctx.Done():
+		s.cancelStmt(done)
+		return ctx.Err()
+	case <-done:
+		return sqlErr
+	}
+}
+
+func (s *session) readLobChunkReq(ctx context.Context, request *p.ReadLobRequest, reply *p.ReadLobReply) error {
+	if err := s.pwr.Write(ctx, p.MtWriteLob, false, request); err != nil {
+		return err
+	}
+	_, err := s.prd.IterateParts(ctx, 0, func(kind p.PartKind, attrs p.PartAttributes) error {
+		if kind == p.PkReadLobReply {
+			return s.prd.ReadPart(ctx, reply, nil)
+		}
+		return p.ErrSkipped
+	})
+	if err == nil {
+		s.metrics.msgCh <- counterMsg{idx: counterLobBytesRead, v: uint64(reply.NumByte())}
+	}
+	return err
+}
+
 // writeLobs writes input lob parameters to db and returns the accumulated rows.
 func (s *session) writeLobs(ctx context.Context, cr *callResult, ids []p.LocatorID, inPrmFields []*p.ParameterField, nvargs []driver.NamedValue) (int64, error) {
 	if len(inPrmFields) != len(nvargs) {
@@ -780,7 +1217,10 @@ func (s *session) writeLobs(ctx context.Context, cr *callResult, ids []p.Locator
 
 		writeLobRequest.Descrs = descrs
 
+		// lock per round trip only as the lob data of the next chunk is fetched outside of the lock
+		s.reqMu.lock()
 		if err := s.pwr.Write(ctx, p.MtReadLob, false, writeLobRequest); err != nil {
+			s.reqMu.unlock()
 			return 0, err
 		}
 
@@ -807,6 +1247,7 @@ func (s *session) writeLobs(ctx context.Context, cr *callResult, ids []p.Locator
 				return p.ErrSkipped
 			}
 		})
+		s.reqMu.unlock()
 		if err != nil {
 			return 0, err
 		}
@@ -21,10 +21,19 @@ type Stats struct {
 	ReadBytes       uint64 // Total bytes read by client connection.
 	WrittenBytes    uint64 // Total bytes written by client connection.
 	SessionConnects uint64 // Total number of session connects (switch users).
+	LobReadBytes    uint64 // Total lob data bytes fetched from the database server (see SQLTimes fetchlob for the time spent).
+	// Read-only transaction routing counters (only filled if read-only routing is enabled).
+	SecondaryTransactions uint64 // Total number of read-only transactions routed to the secondary site.
+	SecondaryFallbacks    uint64 // Total number of read-only transactions executed on the primary site as the secondary site was not available.
 	// Time histograms (Sum and upper bounds in Unit)
 	TimeUnit  string                     // Time unit
 	ReadTime  *StatsHistogram            // Time spent on reading from connection.
 	WriteTime *StatsHistogram            // Time spent on writing to connection.
 	AuthTime  *StatsHistogram            // Time spent on authentication.
 	SQLTimes  map[string]*StatsHistogram // Time spent on different SQL statements.
+	// Server statement context histograms (only filled if the values are reported by the database server).
+	ServerProcessingTime *StatsHistogram // Server processing time of SQL statements (Sum and upper bounds in TimeUnit).
+	ServerCPUTime        *StatsHistogram // Server CPU time of SQL statements (Sum and upper bounds in TimeUnit).
+	MemoryUnit           string          // Memory unit
+	ServerMemoryUsage    *StatsHistogram // Server memory usage of SQL statements (Sum and upper bounds in MemoryUnit).
 }
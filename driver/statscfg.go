@@ -12,9 +12,11 @@ import (
 var statsCfgRaw []byte
 
 var statsCfg struct {
-	TimeUnit        string    `json:"timeUnit"`
-	SQLTimeTexts    []string  `json:"sqlTimeTexts"`
-	TimeUpperBounds []float64 `json:"timeUpperBounds"`
+	TimeUnit          string    `json:"timeUnit"`
+	SQLTimeTexts      []string  `json:"sqlTimeTexts"`
+	TimeUpperBounds   []float64 `json:"timeUpperBounds"`
+	MemoryUnit        string    `json:"memoryUnit"`
+	MemoryUpperBounds []float64 `json:"memoryUpperBounds"`
 }
 
 // time unit map (see go package time format.go).
@@ -29,6 +31,14 @@ var timeUnitMap = map[string]uint64{
 	"h":  uint64(time.Hour),
 }
 
+// memory unit map.
+var memoryUnitMap = map[string]uint64{
+	"B":  1,
+	"KB": 1 << 10,
+	"MB": 1 << 20,
+	"GB": 1 << 30,
+}
+
 func loadStatsCfg() error {
 
 	if err := json.Unmarshal(statsCfgRaw, &statsCfg); err != nil {
@@ -46,9 +56,19 @@ func loadStatsCfg() error {
 		return fmt.Errorf("invalid time unit in statscfg.json %s", statsCfg.TimeUnit)
 	}
 
+	if len(statsCfg.MemoryUpperBounds) == 0 {
+		return fmt.Errorf("number of statscfg.json memoryUpperBounds needs to be greater than %d", 0)
+	}
+	if _, ok := memoryUnitMap[statsCfg.MemoryUnit]; !ok {
+		return fmt.Errorf("invalid memory unit in statscfg.json %s", statsCfg.MemoryUnit)
+	}
+
 	// sort and dedup timeBuckets
 	slices.Sort(statsCfg.TimeUpperBounds)
 	statsCfg.TimeUpperBounds = slices.Compact(statsCfg.TimeUpperBounds)
+	// sort and dedup memoryBuckets
+	slices.Sort(statsCfg.MemoryUpperBounds)
+	statsCfg.MemoryUpperBounds = slices.Compact(statsCfg.MemoryUpperBounds)
 
 	return nil
 }
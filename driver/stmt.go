@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"iter"
+	"log/slog"
 	"slices"
 	"sync"
 )
@@ -20,17 +21,36 @@ var (
 )
 
 type stmt struct {
-	session *session
-	wg      *sync.WaitGroup // from conn
-	attrs   *connAttrs
-	metrics *metrics
-	query   string
-	pr      *prepareResult
+	session     *session        // session the statement is executed on
+	prepSession *session        // session the statement was prepared on
+	prepPR      *prepareResult  // prepare result of prepSession
+	connSession func() *session // from conn: session statements of the connection are executed on
+	wg          *sync.WaitGroup // from conn
+	attrs       *connAttrs
+	metrics     *metrics
+	query       string
+	pr          *prepareResult
+
+	// prepare results of sessions other than prepSession (read-only transaction routing)
+	sessionPRs map[*session]*prepareResult
+
+	// statement routing (nil if disabled)
+	routing *stmtRouting
 
 	// rows: stored procedures with table output parameters
 	rows *sql.Rows
 }
 
+// stmtRouting holds the prepared statements of a statement on the anchor and on the routed session.
+type stmtRouting struct {
+	router        *router
+	anchorSession *session
+	anchorPR      *prepareResult
+	routed        bool           // routing decision taken
+	session       *session       // routed session (nil if executed on anchor session)
+	pr            *prepareResult // prepare result of routed session
+}
+
 type totalRowsAffected int64
 
 func (t *totalRowsAffected) add(r driver.Result) {
@@ -44,9 +64,78 @@ func (t *totalRowsAffected) add(r driver.Result) {
 	*t += totalRowsAffected(rows)
 }
 
-func newStmt(session *session, wg *sync.WaitGroup, attrs *connAttrs, metrics *metrics, query string, pr *prepareResult) *stmt {
+func newStmt(session *session, connSession func() *session, router *router, wg *sync.WaitGroup, attrs *connAttrs, metrics *metrics, query string, pr *prepareResult) *stmt {
 	metrics.msgCh <- gaugeMsg{idx: gaugeStmt, v: 1} // increment number of statements.
-	return &stmt{session: session, wg: wg, attrs: attrs, metrics: metrics, query: query, pr: pr}
+	s := &stmt{session: session, prepSession: session, prepPR: pr, connSession: connSession, wg: wg, attrs: attrs, metrics: metrics, query: query, pr: pr}
+	if router != nil {
+		s.routing = &stmtRouting{router: router, anchorSession: session, anchorPR: pr}
+	}
+	return s
+}
+
+/*
+useSession sets the session and the prepare result the statement is executed on.
+
+If the session of the connection differs from the session the statement was prepared on (read-only transaction
+routing), the statement is prepared once on the session of the connection.
+*/
+func (s *stmt) useSession(ctx context.Context) error {
+	connSession := s.connSession()
+	if connSession == s.prepSession {
+		s.session, s.pr = s.prepSession, s.prepPR
+		return nil
+	}
+	pr, ok := s.sessionPRs[connSession]
+	if !ok {
+		var err error
+		if pr, err = connSession.prepare(ctx, s.query); err != nil {
+			return err
+		}
+		if s.sessionPRs == nil {
+			s.sessionPRs = map[*session]*prepareResult{}
+		}
+		s.sessionPRs[connSession] = pr
+	}
+	s.session, s.pr = connSession, pr
+	return nil
+}
+
+/*
+route sets the session and the prepare result the statement is executed on.
+
+Statements are executed on the anchor session if
+  - executed within a transaction or
+  - the statement is a procedure call.
+
+Otherwise the statement is prepared once on the session the router returns for the table locations
+of the statement. If the statement cannot be prepared on the routed session, the anchor session is used.
+*/
+func (s *stmt) route(ctx context.Context) {
+	r := s.routing
+	if r == nil || s.session != r.anchorSession { // not executed on the session the statement was prepared on
+		return
+	}
+	s.session, s.pr = r.anchorSession, r.anchorPR
+	if r.anchorSession.inTx.Load() || r.anchorPR.isProcedureCall() {
+		return
+	}
+	if r.session != nil && r.session.isBad() { // reconnect
+		r.routed, r.session, r.pr = false, nil, nil
+	}
+	if !r.routed {
+		session := r.router.session(ctx, r.anchorPR.locations)
+		if session != r.anchorSession {
+			if pr, err := session.prepare(ctx, s.query); err != nil {
+				r.router.logger.LogAttrs(ctx, slog.LevelWarn, "statement routing: cannot prepare statement - using anchor connection", slog.String("host", session.host), slog.Any("error", err))
+			} else {
+				r.session, r.pr = session, pr
+			}
+		}
+		r.routed = true
+	}
+	if r.session != nil {
+		s.session, s.pr = r.session, r.pr
+	}
 }
 
 /*
@@ -65,6 +154,18 @@ func (s *stmt) Close() error {
 		s.rows.Close()
 	}
 
+	if r := s.routing; r != nil {
+		if r.session != nil && !r.session.isBad() {
+			r.session.dropStatementID(context.Background(), r.pr.stmtID) //nolint:errcheck
+		}
+	}
+	for session, pr := range s.sessionPRs {
+		if !session.isBad() {
+			session.dropStatementID(context.Background(), pr.stmtID) //nolint:errcheck
+		}
+	}
+	s.session, s.pr = s.prepSession, s.prepPR
+
 	if s.session.isBad() {
 		return driver.ErrBadConn
 	}
@@ -81,9 +182,14 @@ func (s *stmt) QueryContext(ctx context.Context, nvargs []driver.NamedValue) (dr
 	if s.pr.isProcedureCall() {
 		return nil, fmt.Errorf("invalid procedure call %s - please use Exec instead", s.query)
 	}
+	if err := s.useSession(ctx); err != nil {
+		return nil, err
+	}
 	if err := s.session.preventSwitchUser(ctx); err != nil {
 		return nil, err
 	}
+	s.route(ctx)
+	resetServerStmtStats(ctx)
 
 	var sqlErr error
 	var rows driver.Rows
@@ -95,7 +201,9 @@ func (s *stmt) QueryContext(ctx context.Context, nvargs []driver.NamedValue) (dr
 
 	select {
 	case <-ctx.Done():
-		s.session.cancel()
+		if s.session.cancelStmt(done) && rows != nil {
+			rows.Close() // release the resultset opened before the cancellation took effect.
+		}
 		return nil, ctx.Err()
 	case <-done:
 		return rows, sqlErr
@@ -106,9 +214,14 @@ func (s *stmt) ExecContext(ctx context.Context, nvargs []driver.NamedValue) (dri
 	if hookFn, ok := ctx.Value(connHookCtxKey).(connHookFn); ok {
 		hookFn(choStmtExec)
 	}
+	if err := s.useSession(ctx); err != nil {
+		return nil, err
+	}
 	if err := s.session.preventSwitchUser(ctx); err != nil {
 		return nil, err
 	}
+	s.route(ctx)
+	resetServerStmtStats(ctx)
 
 	var sqlErr error
 	var result driver.Result
@@ -125,7 +238,9 @@ func (s *stmt) ExecContext(ctx context.Context, nvargs []driver.NamedValue) (dri
 
 	select {
 	case <-ctx.Done():
-		s.session.cancel()
+		if s.session.cancelStmt(done) && rows != nil {
+			rows.Close() // release the output resultsets of a call finished before the cancellation took effect.
+		}
 		return nil, ctx.Err()
 	case <-done:
 		s.rows = rows
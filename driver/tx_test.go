@@ -3,7 +3,9 @@
 package driver_test
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -109,6 +111,110 @@ func testTransactionRollback(t *testing.T, db *sql.DB) {
 	}
 }
 
+func testTransactionSavepoint(t *testing.T, db *sql.DB) {
+	ctx := context.Background()
+
+	table := driver.RandomIdentifier("testTxSavepoint_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i tinyint)", table)); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if _, err := tx.Exec(fmt.Sprintf("insert into %s values(1)", table)); err != nil {
+		t.Fatal(err)
+	}
+	if err := driver.Savepoint(ctx, tx, "sp1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(fmt.Sprintf("insert into %s values(2)", table)); err != nil {
+		t.Fatal(err)
+	}
+	if err := driver.Savepoint(ctx, tx, "sp2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(fmt.Sprintf("insert into %s values(3)", table)); err != nil {
+		t.Fatal(err)
+	}
+
+	// rollback to sp1 releases sp2
+	if err := driver.RollbackToSavepoint(ctx, tx, "sp1"); err != nil {
+		t.Fatal(err)
+	}
+	var unknownSavepointError *driver.UnknownSavepointError
+	if err := driver.RollbackToSavepoint(ctx, tx, "sp2"); !errors.As(err, &unknownSavepointError) {
+		t.Fatalf("error %v - expected %T", err, unknownSavepointError)
+	}
+	if err := driver.ReleaseSavepoint(ctx, tx, "sp1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	i := 0
+	if err := db.QueryRow(fmt.Sprintf("select count(*) from %s", table)).Scan(&i); err != nil {
+		t.Fatal(err)
+	}
+	if i != 1 {
+		t.Fatal(fmt.Errorf("invalid number of records %d - 1 expected", i))
+	}
+
+	// savepoint outside of transaction
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if err := conn.Raw(func(driverConn any) error {
+		return driverConn.(driver.SavepointConn).Savepoint(ctx, "sp1")
+	}); !errors.Is(err, driver.ErrNotInTransaction) {
+		t.Fatalf("error %v - expected %v", err, driver.ErrNotInTransaction)
+	}
+}
+
+func testTransactionReadOnlyRouting(t *testing.T, db *sql.DB) {
+	connector := driver.MT.NewConnector()
+	connector.SetReadOnlyRouting(true)
+	exDB := driver.OpenDB(connector)
+
+	const numTx = 3
+	for range numTx {
+		tx, err := exDB.BeginTx(t.Context(), &sql.TxOptions{ReadOnly: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var s string
+		if err := tx.QueryRow("select * from dummy").Scan(&s); err != nil {
+			t.Fatal(err)
+		}
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// read-write transactions are not routed.
+	tx, err := exDB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := exDB.Close(); err != nil { // close database to collect all metrics
+		t.Fatal(err)
+	}
+	stats := exDB.ExStats()
+	// read-only transactions are either routed to the secondary site or executed on the primary site (no Active/Active system replication).
+	if n := stats.SecondaryTransactions + stats.SecondaryFallbacks; n != numTx {
+		t.Fatalf("number of routed read-only transactions %d - expected %d", n, numTx)
+	}
+}
+
 func TestTransaction(t *testing.T) {
 	tests := []struct {
 		name string
@@ -116,6 +222,8 @@ func TestTransaction(t *testing.T) {
 	}{
 		{"transactionCommit", testTransactionCommit},
 		{"transactionRollback", testTransactionRollback},
+		{"transactionSavepoint", testTransactionSavepoint},
+		{"transactionReadOnlyRouting", testTransactionReadOnlyRouting},
 	}
 
 	db := driver.MT.DB()
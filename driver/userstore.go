@@ -0,0 +1,168 @@
+package driver
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// UserStoreFileEnv is the name of the environment variable defining the location of the user store file.
+const UserStoreFileEnv = "GOHDBUSERSTORE"
+
+// defaultUserStoreFile is the location of the user store file relative to the user home directory.
+const defaultUserStoreFile = ".hdb/gohdbuserstore"
+
+// user store entry attributes.
+const (
+	usEnv      = "env"
+	usUser     = "user"
+	usPassword = "password"
+	usDatabase = "database"
+)
+
+// ErrUserStoreKeyNotFound is returned if a key is not defined in the user store file.
+var ErrUserStoreKeyNotFound = errors.New("user store key not found")
+
+// UserStoreEntry is an entry of the user store.
+type UserStoreEntry struct {
+	Key      string
+	Env      string // host:port (multiple hosts separated by comma)
+	User     string
+	Password string
+	Database string
+}
+
+/*
+DefaultUserStoreFile returns the location of the user store file.
+
+The location is defined by the environment variable GOHDBUSERSTORE and defaults to .hdb/gohdbuserstore in the
+home directory of the user.
+*/
+func DefaultUserStoreFile() (string, error) {
+	if file, ok := os.LookupEnv(UserStoreFileEnv); ok {
+		return file, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, defaultUserStoreFile), nil
+}
+
+/*
+ReadUserStoreEntry reads the entry of a key from a user store file.
+
+Like the keys of the SAP HANA hdbuserstore, each key of the user store file defines the database hosts (env),
+the user, the password and optionally the tenant database name. Keys are case insensitive. Lines starting with
+'#' or ';' are comments. The user store file must not be accessible by group or others (unix).
+
+Example:
+
+	# development system
+	[DEV]
+	env = host1:30015,host2:30015
+	user = MYUSER
+	password = secret
+	database = HDB
+*/
+func ReadUserStoreEntry(file, key string) (*UserStoreEntry, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if runtime.GOOS != "windows" {
+		fi, err := f.Stat()
+		if err != nil {
+			return nil, err
+		}
+		if fi.Mode().Perm()&0o077 != 0 {
+			return nil, fmt.Errorf("user store file %s is accessible by group or others (permissions %s)", file, fi.Mode().Perm())
+		}
+	}
+	entry, err := readUserStoreEntry(f, key)
+	if err != nil {
+		return nil, fmt.Errorf("user store file %s: %w", file, err)
+	}
+	return entry, nil
+}
+
+func readUserStoreEntry(r io.Reader, key string) (*UserStoreEntry, error) {
+	var entry *UserStoreEntry
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || line[0] == '#' || line[0] == ';':
+			continue
+		case line[0] == '[':
+			if entry != nil { // entry complete
+				return entry, nil
+			}
+			name, ok := strings.CutSuffix(line[1:], "]")
+			if !ok {
+				return nil, fmt.Errorf("line %d: invalid key %s", lineNo, line)
+			}
+			if strings.EqualFold(strings.TrimSpace(name), key) {
+				entry = &UserStoreEntry{Key: key}
+			}
+		default:
+			k, v, ok := strings.Cut(line, "=")
+			if !ok {
+				return nil, fmt.Errorf("line %d: invalid attribute %s", lineNo, line)
+			}
+			if entry == nil {
+				continue
+			}
+			k, v = strings.ToLower(strings.TrimSpace(k)), strings.TrimSpace(v)
+			switch k {
+			case usEnv:
+				entry.Env = v
+			case usUser:
+				entry.User = v
+			case usPassword:
+				entry.Password = v
+			case usDatabase:
+				entry.Database = v
+			default:
+				return nil, fmt.Errorf("line %d: invalid attribute %s", lineNo, k)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUserStoreKeyNotFound, key)
+	}
+	return entry, nil
+}
+
+// NewUserStoreConnector creates a connector for basic authentication from a key of the default user store file (see DefaultUserStoreFile).
+func NewUserStoreConnector(key string) (*Connector, error) {
+	file, err := DefaultUserStoreFile()
+	if err != nil {
+		return nil, err
+	}
+	return NewUserStoreFileConnector(file, key)
+}
+
+// NewUserStoreFileConnector creates a connector for basic authentication from a key of a user store file (see ReadUserStoreEntry).
+func NewUserStoreFileConnector(file, key string) (*Connector, error) {
+	entry, err := ReadUserStoreEntry(file, key)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Env == "" {
+		return nil, fmt.Errorf("user store file %s: key %s: missing %s", file, key, usEnv)
+	}
+	c := NewBasicAuthConnector(entry.Env, entry.User, entry.Password)
+	c._databaseName = entry.Database
+	return c, nil
+}
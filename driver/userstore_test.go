@@ -0,0 +1,69 @@
+package driver
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+const testUserStore = `# test user store
+[DEV]
+env = host1:30015,host2:30015
+user = DEVUSER
+password = dev secret
+
+; production system
+[prod]
+ENV = prodhost:30015
+User = PRODUSER
+Password = prod=secret
+Database = HDB
+`
+
+func TestUserStore(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "userstore")
+	if err := os.WriteFile(file, []byte(testUserStore), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testData := []struct {
+		key   string
+		entry UserStoreEntry
+	}{
+		{"DEV", UserStoreEntry{Key: "DEV", Env: "host1:30015,host2:30015", User: "DEVUSER", Password: "dev secret"}},
+		{"PROD", UserStoreEntry{Key: "PROD", Env: "prodhost:30015", User: "PRODUSER", Password: "prod=secret", Database: "HDB"}},
+	}
+	for i, d := range testData {
+		entry, err := ReadUserStoreEntry(file, d.key)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if *entry != d.entry {
+			t.Fatalf("%d: entry %v - expected %v", i, *entry, d.entry)
+		}
+	}
+
+	if _, err := ReadUserStoreEntry(file, "QA"); !errors.Is(err, ErrUserStoreKeyNotFound) {
+		t.Fatalf("error %v - expected %v", err, ErrUserStoreKeyNotFound)
+	}
+
+	t.Setenv(UserStoreFileEnv, file)
+	c, err := NewUserStoreConnector("prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Host() != "prodhost:30015" || c.Username() != "PRODUSER" || c.Password() != "prod=secret" || c.DatabaseName() != "HDB" {
+		t.Fatalf("invalid connector attributes host %s username %s database name %s", c.Host(), c.Username(), c.DatabaseName())
+	}
+
+	if runtime.GOOS != "windows" {
+		if err := os.Chmod(file, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ReadUserStoreEntry(file, "DEV"); err == nil {
+			t.Fatal("expected file permission error")
+		}
+	}
+}
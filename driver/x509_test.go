@@ -0,0 +1,57 @@
+package driver
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// PKCS#12 bundle generated with openssl (P-256 key, self-signed certificate valid until 2125, password "secret"):
+//
+//	openssl pkcs12 -export -in cert.pem -inkey key.pem -out client.p12
+const testP12 = `
+MIIEDAIBAzCCA8IGCSqGSIb3DQEHAaCCA7MEggOvMIIDqzCCAmIGCSqGSIb3DQEHBqCCAlMwggJP
+AgEAMIICSAYJKoZIhvcNAQcBMFcGCSqGSIb3DQEFDTBKMCkGCSqGSIb3DQEFDDAcBAiiZgJsmjHr
+CwICCAAwDAYIKoZIhvcNAgkFADAdBglghkgBZQMEASoEEK0DgQC0itIS/7jb3gNJ9U6AggHgFdRX
+Mt96cIcm6WslNCvR+sjlw34Uhm+9X/M0hBfI1mUDajDFDnvql+d1o715IQ6fnLCx5DU2wzzYq+Ru
+0b0EVDj7uZjDdtKwrjuCO9XZz8B7t5wNxSKFvrbLKDnyV8vvRp+S+xcwIUmDWqzois+EPQ8g+eJr
+2yhWHDCiiuq4/X2dAGeCEKERKLK8xz1DrbCm897sY/+Nj11Lk7O5+WPaiQQYKzC69IBeJMo1EQuL
+mYQFQyGbk3DSPBioaOnKUyBAb/66bQncryS3ItLORoII082v1sVbpb6XWlu8e6VjY5eaVBp7hvfm
+id1yZ4AsGSlZRdgfnQ9pQrgpkH3EbCv7yGnsU4r1wV4Sez/cbT8YwEFQ+wZCswXIe0UZYbRBgLe0
+H5TsI2/jcOFWi5FiokrbcLW9k9SADR+MJ5WBo4PlpCXvaZbReV1aqw3K1IE5sjaKoND1F7Hgtoit
+9g6ZQypUwD3PhMDm2vwKOYCpC2VF5azvZpPgE4WXTJmLiLW5tz1iji9X2BoSUnx9/Xl6V3D1Fdww
+dBGorcvqbGbyqY2kzmiq5QJsvVR4gsO4Irv+5V0JFzFUSHXNKNyeMBS3goCScY3OeL5Rz2GO36yp
+4t6k2ptCUFRrbR1bu7b7U0UhOWPKMIIBQQYJKoZIhvcNAQcBoIIBMgSCAS4wggEqMIIBJgYLKoZI
+hvcNAQwKAQKgge8wgewwVwYJKoZIhvcNAQUNMEowKQYJKoZIhvcNAQUMMBwECBoVv8pDdNRqAgII
+ADAMBggqhkiG9w0CCQUAMB0GCWCGSAFlAwQBKgQQin5VmWsmSlU/QEKmNF9whQSBkHOdiMSb/5nV
+IO6ojHkdcBaGwWvEnJ4ZXhQSeypfXB45vE/VpdwQZX3O+VCynd87Bb9UkxL2jc0MnVocbTxfkkpQ
+G48Oc06AjbGRVjZo+BQdn0DBqni/WuYGXy7lYsJPlebT0X7lk/E8I/yx2jIvzmQrSPS+Do7sNHRd
+6pkg1bvkb6vO0h4NfrhOhSOj+6TwQTElMCMGCSqGSIb3DQEJFTEWBBQ9XFDWzK00SERUko+paor2
+z6spYDBBMDEwDQYJYIZIAWUDBAIBBQAEIFd1aVswpsKe8dpG0GJaS3QBo+BOWB8Knb9WKSSJbj7t
+BAi+DlpaIzjaxgICCAA=`
+
+func TestX509AuthConnectorPKCS12File(t *testing.T) {
+	p12, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(strings.TrimSpace(testP12), "\n", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p12File := filepath.Join(t.TempDir(), "client.p12")
+	if err := os.WriteFile(p12File, p12, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := NewX509AuthConnectorByFilesWithPassword("host:30015", p12File, "", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCert, clientKey := c.ClientCert()
+	if !bytes.Equal(clientCert, p12) {
+		t.Fatal("client certificate does not match PKCS#12 bundle")
+	}
+	if len(clientKey) != 0 {
+		t.Fatalf("client key length %d - expected 0", len(clientKey))
+	}
+}
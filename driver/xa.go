@@ -0,0 +1,181 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+// ErrXANotEnabled is returned by XAConn functions if X/Open XA transactions are not enabled for the connection.
+var ErrXANotEnabled = errors.New("X/Open XA transactions are not enabled - please use Connector.SetXA")
+
+// XAFlags represents the X/Open XA flags.
+type XAFlags int32
+
+// XAFlags constants (see X/Open XA specification).
+const (
+	XANoFlags XAFlags = 0x00000000 // No flags.
+	XAJoin    XAFlags = 0x00200000 // XAStart: join an existing transaction branch.
+	XAResume  XAFlags = 0x08000000 // XAStart: resume a suspended transaction branch.
+	XASuccess XAFlags = 0x04000000 // XAEnd: the work of the transaction branch completed successfully.
+	XAFail    XAFlags = 0x20000000 // XAEnd: the work of the transaction branch failed.
+	XASuspend XAFlags = 0x02000000 // XAEnd: suspend the transaction branch.
+
+	xaOnePhase   XAFlags = 0x40000000 // XACommit: one phase commit optimization.
+	xaStartRScan XAFlags = 0x01000000 // XARecover: start recovery scan.
+	xaEndRScan   XAFlags = 0x00800000 // XARecover: end recovery scan.
+)
+
+// XID size limits (see X/Open XA specification).
+const (
+	maxXIDGlobalTransactionIDSize = 64
+	maxXIDBranchQualifierSize     = 64
+)
+
+/*
+XID represents a X/Open XA transaction branch identifier.
+
+	type XID struct {
+		FormatID            int32
+		GlobalTransactionID []byte
+		BranchQualifier     []byte
+	}
+*/
+type XID = p.XID
+
+func checkXID(xid *XID) error {
+	switch {
+	case xid == nil:
+		return fmt.Errorf("invalid XID: nil")
+	case len(xid.GlobalTransactionID) == 0 || len(xid.GlobalTransactionID) > maxXIDGlobalTransactionIDSize:
+		return fmt.Errorf("invalid XID global transaction id size %d - expected 1 - %d", len(xid.GlobalTransactionID), maxXIDGlobalTransactionIDSize)
+	case len(xid.BranchQualifier) > maxXIDBranchQualifierSize:
+		return fmt.Errorf("invalid XID branch qualifier size %d - maximum %d", len(xid.BranchQualifier), maxXIDBranchQualifierSize)
+	}
+	return nil
+}
+
+/*
+XAConn enhances a connection with X/Open XA (distributed transaction, two-phase commit) functions.
+
+The functions can be used via sql.Conn.Raw. Statements executed on the connection between XAStart and XAEnd
+are part of the transaction branch identified by the XID and are not committed automatically.
+Transaction branches which are prepared but not committed or rolled back (in-doubt transactions),
+e.g. after a crash of the transaction manager, can be retrieved via XARecover and finalized by
+XACommit or XARollback.
+
+X/Open XA transactions need to be enabled via Connector.SetXA, otherwise the functions return ErrXANotEnabled.
+*/
+type XAConn interface {
+	// XAStart starts (or joins / resumes) work on behalf of the transaction branch xid.
+	XAStart(ctx context.Context, xid *XID, flags XAFlags) error
+	// XAEnd ends (or suspends) work on behalf of the transaction branch xid.
+	XAEnd(ctx context.Context, xid *XID, flags XAFlags) error
+	// XAPrepare prepares the transaction branch xid to be committed.
+	XAPrepare(ctx context.Context, xid *XID) error
+	// XACommit commits the transaction branch xid. If onePhase is true, the transaction branch is committed without a prior prepare.
+	XACommit(ctx context.Context, xid *XID, onePhase bool) error
+	// XARollback rolls back the transaction branch xid.
+	XARollback(ctx context.Context, xid *XID) error
+	// XARecover returns the prepared (in-doubt) transaction branches.
+	XARecover(ctx context.Context) ([]*XID, error)
+	// XAForget lets the database forget a heuristically completed transaction branch xid.
+	XAForget(ctx context.Context, xid *XID) error
+}
+
+var _ XAConn = (*conn)(nil)
+
+func (c *conn) xa(ctx context.Context, fn func() error) error {
+	if !c.session.attrs.xa {
+		return ErrXANotEnabled
+	}
+	var sqlErr error
+	done := make(chan struct{})
+	c.wg.Go(func() {
+		defer close(done)
+		sqlErr = fn()
+	})
+
+	select {
+	case <-ctx.Done():
+		c.session.cancelStmt(done)
+		return ctx.Err()
+	case <-done:
+		return sqlErr
+	}
+}
+
+func (c *conn) xaExec(ctx context.Context, messageType p.MessageType, xid *XID, flags XAFlags) error {
+	if err := checkXID(xid); err != nil {
+		return err
+	}
+	return c.xa(ctx, func() error {
+		return c.session.xa(ctx, messageType, int32(flags), xid)
+	})
+}
+
+// XAStart implements the XAConn interface.
+func (c *conn) XAStart(ctx context.Context, xid *XID, flags XAFlags) error {
+	if c.session.inTx.Load() {
+		return ErrNestedTransaction
+	}
+	if err := c.xaExec(ctx, p.MtXopenXAStart, xid, flags); err != nil {
+		return err
+	}
+	c.session.inTx.Store(true)
+	return nil
+}
+
+// XAEnd implements the XAConn interface.
+func (c *conn) XAEnd(ctx context.Context, xid *XID, flags XAFlags) error {
+	if !c.session.attrs.xa {
+		return ErrXANotEnabled
+	}
+	if flags == XANoFlags {
+		flags = XASuccess
+	}
+	defer func() {
+		c.session.inTx.Store(false)
+		c.session.savepoints = nil
+	}()
+	return c.xaExec(ctx, p.MtXopenXAEnd, xid, flags)
+}
+
+// XAPrepare implements the XAConn interface.
+func (c *conn) XAPrepare(ctx context.Context, xid *XID) error {
+	return c.xaExec(ctx, p.MtXopenXAPrepare, xid, XANoFlags)
+}
+
+// XACommit implements the XAConn interface.
+func (c *conn) XACommit(ctx context.Context, xid *XID, onePhase bool) error {
+	flags := XANoFlags
+	if onePhase {
+		flags = xaOnePhase
+	}
+	return c.xaExec(ctx, p.MtXopenXACommit, xid, flags)
+}
+
+// XARollback implements the XAConn interface.
+func (c *conn) XARollback(ctx context.Context, xid *XID) error {
+	return c.xaExec(ctx, p.MtXopenXARollback, xid, XANoFlags)
+}
+
+// XAForget implements the XAConn interface.
+func (c *conn) XAForget(ctx context.Context, xid *XID) error {
+	return c.xaExec(ctx, p.MtXopenXAForget, xid, XANoFlags)
+}
+
+// XARecover implements the XAConn interface.
+func (c *conn) XARecover(ctx context.Context) ([]*XID, error) {
+	var xids []*XID
+	if err := c.xa(ctx, func() error {
+		var err error
+		xids, err = c.session.xaRecover(ctx, int32(xaStartRScan|xaEndRScan))
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return xids, nil
+}